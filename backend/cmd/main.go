@@ -14,16 +14,15 @@ import (
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
-	"gorm.io/gorm"
 
 	_ "cashone/docs"
-	"cashone/domain/repository"
-	"cashone/domain/service"
+	"cashone/infrastructure/audit"
 	"cashone/infrastructure/database"
 	"cashone/infrastructure/handler"
 	authMiddleware "cashone/infrastructure/middleware"
 	infrarepo "cashone/infrastructure/repository"
 	infraservice "cashone/infrastructure/service"
+	"cashone/infrastructure/telemetry"
 	"cashone/pkg/config"
 )
 
@@ -83,12 +82,6 @@ func setupEcho(cfg *config.Config, log *zap.SugaredLogger) *echo.Echo {
 	return e
 }
 
-func initDependencies(db *gorm.DB, cfg *config.Config, log *zap.SugaredLogger) (repository.Factory, service.Factory) {
-	repoFactory := infrarepo.NewFactory(db, log)
-	serviceFactory := infraservice.NewFactory(repoFactory, cfg, log)
-	return repoFactory, serviceFactory
-}
-
 func main() {
 	// Load configuration
 	cfg, err := config.Load()
@@ -106,6 +99,12 @@ func main() {
 	defer logger.Sync()
 	sugar := logger.Sugar()
 
+	// Validate password hashing configuration before anything else starts
+	passwordHasher, err := infraservice.NewPasswordHasher(&cfg.Security.Password)
+	if err != nil {
+		sugar.Fatalf("Invalid password hashing configuration: %v", err)
+	}
+
 	// Initialize database
 	db, err := database.NewPostgresDB(sugar, &cfg.Database)
 	if err != nil {
@@ -116,17 +115,46 @@ func main() {
 	// Initialize Echo
 	e := setupEcho(cfg, sugar)
 
-	// Initialize dependencies
-	repoFactory, serviceFactory := initDependencies(db.GormDB(), cfg, sugar)
+	// Initialize opt-in usage telemetry
+	collector := telemetry.NewCollector(&cfg.Telemetry, sugar)
+	e.Use(authMiddleware.NewTelemetryMiddleware(collector).Track)
+	telemetryCtx, stopTelemetry := context.WithCancel(context.Background())
+	defer stopTelemetry()
+	go collector.Run(telemetryCtx)
+
+	// Initialize audit event export
+	auditExporter, err := audit.NewExporter(&cfg.Logger.Audit, sugar)
+	if err != nil {
+		sugar.Fatalf("Failed to initialize audit exporter: %v", err)
+	}
+	auditCtx, stopAudit := context.WithCancel(context.Background())
+	go auditExporter.Run(auditCtx)
+
+	// Initialize repositories
+	repoFactory := infrarepo.NewFactory(db.GormDB(), sugar)
+
+	// Initialize maintenance mode, shared with other instances through the database
+	maintenance := authMiddleware.NewMaintenanceMode(&cfg.Maintenance, repoFactory.NewMaintenanceRepository(), sugar)
+	maintenanceCtx, stopMaintenance := context.WithCancel(context.Background())
+	defer stopMaintenance()
+	go maintenance.Run(maintenanceCtx)
+	adminMiddleware := authMiddleware.NewAdminMiddleware(cfg.Admin.Token, auditExporter, sugar)
+	e.Use(maintenance.Guard)
+
+	// Initialize services
+	serviceFactory := infraservice.NewFactory(repoFactory, passwordHasher, auditExporter, maintenance, cfg, sugar)
 	auth := serviceFactory.NewAuthService()
 	authMiddleware := authMiddleware.NewAuthMiddleware(auth, sugar)
 
 	// Initialize handlers
-	handler.NewHealthHandler(e, sugar, repoFactory, serviceFactory)
+	handler.NewHealthHandler(e, sugar, repoFactory, serviceFactory, maintenance)
+	handler.NewAdminHandler(e, sugar, maintenance, adminMiddleware, auditExporter)
 	handler.NewAuthHandler(e, sugar, auth)
 	handler.NewCategoryHandler(e, sugar, serviceFactory.NewCategoryService(), authMiddleware)
+	handler.NewCardHandler(e, sugar, serviceFactory.NewCardService(), authMiddleware)
 	handler.NewTransactionHandler(e, sugar, serviceFactory.NewTransactionService(), authMiddleware)
 	handler.NewMonobankHandler(e, sugar, serviceFactory.NewMonobankService(), authMiddleware)
+	handler.NewInsightsHandler(e, sugar, serviceFactory.NewInsightsService(), authMiddleware)
 
 	// Start server
 	go func() {
@@ -147,4 +175,8 @@ func main() {
 	if err := e.Shutdown(ctx); err != nil {
 		sugar.Fatalf("Failed to shutdown server: %v", err)
 	}
+
+	// Flush queued audit events
+	stopAudit()
+	auditExporter.Wait()
 }
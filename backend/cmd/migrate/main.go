@@ -2,6 +2,7 @@ package main
 
 import (
 	"cashone/infrastructure/database"
+	"cashone/pkg/config"
 	"flag"
 	"fmt"
 	"log"
@@ -56,10 +57,14 @@ func main() {
 
 	// Parse command line arguments
 	command := flag.String("command", "", "Migration command (up/down/status)")
+	snapshot := flag.Bool("snapshot", false, "Take a database snapshot before applying or rolling back migrations")
+	snapshotDir := flag.String("snapshot-dir", "backups", "Directory where database snapshots are stored")
+	rollbackToSnapshot := flag.String("rollback-to-snapshot", "", "Restore the database from a snapshot file (or \"latest\")")
 	flag.Parse()
 
-	if *command == "" {
-		fmt.Println("Usage: migrate -command [up|down|status]")
+	if *command == "" && *rollbackToSnapshot == "" {
+		fmt.Println("Usage: migrate -command [up|down|status] [-snapshot] [-snapshot-dir dir]")
+		fmt.Println("       migrate -rollback-to-snapshot [path|latest] [-snapshot-dir dir]")
 		os.Exit(1)
 	}
 
@@ -95,12 +100,21 @@ func main() {
 
 	// Database configuration
 	dbConfig := viper.GetStringMapString("database")
-	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		dbConfig["host"],
-		dbConfig["port"],
-		os.Getenv("CASHONE_DATABASE_USER"),
-		os.Getenv("CASHONE_DATABASE_PASSWORD"),
-		os.Getenv("CASHONE_DATABASE_NAME"),
+	dbCfg := &config.DatabaseConfig{
+		Host:     dbConfig["host"],
+		Port:     dbConfig["port"],
+		User:     os.Getenv("CASHONE_DATABASE_USER"),
+		Password: os.Getenv("CASHONE_DATABASE_PASSWORD"),
+		Name:     os.Getenv("CASHONE_DATABASE_NAME"),
+		SSLMode:  "disable",
+	}
+	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		dbCfg.Host,
+		dbCfg.Port,
+		dbCfg.User,
+		dbCfg.Password,
+		dbCfg.Name,
+		dbCfg.SSLMode,
 	)
 
 	// Connect to database
@@ -111,6 +125,17 @@ func main() {
 
 	// Create migration manager
 	migrationManager := database.NewMigrationManager(db)
+	if *snapshot || *rollbackToSnapshot != "" {
+		migrationManager.SetSnapshotter(database.NewSnapshotter(dbCfg, *snapshotDir))
+	}
+
+	// Restore from snapshot takes precedence over regular commands
+	if *rollbackToSnapshot != "" {
+		if err := migrationManager.RollbackToSnapshot(*rollbackToSnapshot); err != nil {
+			log.Fatalf("Snapshot rollback error: %v", err)
+		}
+		return
+	}
 
 	// Execute command
 	var cmdErr error
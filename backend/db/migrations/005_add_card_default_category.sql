@@ -0,0 +1,2 @@
+-- Add default category to cards table
+ALTER TABLE cards ADD COLUMN default_category_id UUID REFERENCES categories(id) ON DELETE SET NULL;
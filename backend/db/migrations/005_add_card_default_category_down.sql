@@ -0,0 +1,2 @@
+-- Remove default category from cards table
+ALTER TABLE cards DROP COLUMN default_category_id;
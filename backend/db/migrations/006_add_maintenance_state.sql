@@ -0,0 +1,11 @@
+-- Persist the maintenance mode switch so it is shared by all server instances and survives restarts
+CREATE TABLE maintenance_states (
+    id SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    reason TEXT NOT NULL DEFAULT '',
+    since TIMESTAMP WITH TIME ZONE,
+    retry_after INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO maintenance_states (id) VALUES (1);
@@ -0,0 +1,2 @@
+-- Remove the persisted maintenance mode switch
+DROP TABLE IF EXISTS maintenance_states;
@@ -24,6 +24,9 @@ package docs
 // @tag.name health
 // @tag.description Health check endpoints for monitoring service status
 
+// @tag.name admin
+// @tag.description Operator endpoints protected by the admin token
+
 // @tag.name auth
 // @tag.description Authentication and authorization endpoints
 
@@ -36,5 +39,8 @@ package docs
 // @tag.name categories
 // @tag.description Category management endpoints for organizing transactions
 
+// @tag.name insights
+// @tag.description Spending insights computed from transaction history
+
 // @tag.name monobank
 // @tag.description Monobank integration endpoints for syncing cards and transactions
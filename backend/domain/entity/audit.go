@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audit event types
+const (
+	AuditUserRegistered     = "auth.register"
+	AuditLoginSucceeded     = "auth.login.success"
+	AuditLoginFailed        = "auth.login.failure"
+	AuditTokenRefreshFailed = "auth.refresh.failure"
+	AuditLogout             = "auth.logout"
+	AuditTokensRevoked      = "auth.tokens.revoked"
+	AuditPasswordRehashed   = "auth.password.rehashed"
+	AuditMaintenanceToggled = "admin.maintenance"
+	AuditAdminAccessDenied  = "admin.access.denied"
+)
+
+// Audit event outcomes
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+)
+
+// Audit event severities on the CEF 0-10 scale
+const (
+	AuditSeverityInformation = 3
+	AuditSeverityNotice      = 5
+	AuditSeverityWarning     = 7
+)
+
+// AuditEvent represents a security-relevant event exported to audit sinks.
+// It must never contain passwords, tokens or financial data.
+type AuditEvent struct {
+	Time      time.Time  `json:"time"`
+	Type      string     `json:"type"`
+	Outcome   string     `json:"outcome"`
+	Severity  int        `json:"severity"`
+	UserID    *uuid.UUID `json:"user_id,omitempty"`
+	Email     string     `json:"email,omitempty"`
+	IP        string     `json:"ip,omitempty"`
+	UserAgent string     `json:"user_agent,omitempty"`
+	Message   string     `json:"message,omitempty"`
+}
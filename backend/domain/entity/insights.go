@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SpendingBaselines represents a user's typical spending over a rolling window of full months.
+// Baselines are grouped by card currency and amounts are in minor units of that currency;
+// purchases made in another currency are counted in the card's currency, as charged.
+// A user with cards in several currencies gets one baseline per currency.
+type SpendingBaselines struct {
+	From       time.Time          `json:"from"`
+	To         time.Time          `json:"to"`
+	Months     int                `json:"months"`
+	Categories []CategoryBaseline `json:"categories"`
+	MCCs       []MCCBaseline      `json:"mccs"`
+	Merchants  []MerchantBaseline `json:"merchants"`
+}
+
+// CategoryBaseline represents the typical monthly spend in a category
+type CategoryBaseline struct {
+	CategoryID         *uuid.UUID `json:"category_id"`
+	CurrencyCode       int        `json:"currency_code"` // ISO 4217 code of the card currency
+	MedianMonthlySpend int64      `json:"median_monthly_spend"`
+	TransactionCount   int        `json:"transaction_count"`
+}
+
+// MCCBaseline represents the typical transaction size for a merchant category code
+type MCCBaseline struct {
+	MCC                     int   `json:"mcc"`
+	CurrencyCode            int   `json:"currency_code"` // ISO 4217 code of the card currency
+	MedianTransactionAmount int64 `json:"median_transaction_amount"`
+	TransactionCount        int   `json:"transaction_count"`
+}
+
+// MerchantBaseline represents the typical transaction size at a merchant
+type MerchantBaseline struct {
+	Merchant                string `json:"merchant"`
+	MCC                     int    `json:"mcc"`
+	CurrencyCode            int    `json:"currency_code"` // ISO 4217 code of the card currency
+	MedianTransactionAmount int64  `json:"median_transaction_amount"`
+	TransactionCount        int    `json:"transaction_count"`
+}
@@ -5,5 +5,6 @@ import "time"
 // Migration represents a database migration record
 type Migration struct {
 	Version   string    `gorm:"primaryKey"`
+	Checksum  string    `gorm:"type:varchar(64)"`
 	AppliedAt time.Time `gorm:"autoCreateTime"`
 }
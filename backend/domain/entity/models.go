@@ -26,17 +26,18 @@ type User struct {
 // Card represents a bank card
 type Card struct {
 	Base
-	UserID            uuid.UUID `gorm:"type:uuid;not null" json:"user_id"`
-	Name              string    `gorm:"type:varchar(255);not null" json:"name"`
-	CardName          string    `gorm:"type:varchar(255)" json:"card_name"`
-	MaskedPan         string    `gorm:"type:varchar(255)" json:"masked_pan"`
-	MonobankID        string    `gorm:"type:varchar(255);unique" json:"monobank_id"`
-	MonobankAccountID string    `gorm:"type:varchar(255)" json:"monobank_account_id"`
-	Balance           int64     `gorm:"not null" json:"balance"`
-	CreditLimit       int64     `gorm:"not null;default:0" json:"credit_limit"`
-	CurrencyCode      int       `gorm:"not null" json:"currency_code"`
-	Type              string    `gorm:"type:varchar(50)" json:"type"`
-	IsManual          bool      `gorm:"not null;default:false" json:"is_manual"`
+	UserID            uuid.UUID  `gorm:"type:uuid;not null" json:"user_id"`
+	Name              string     `gorm:"type:varchar(255);not null" json:"name"`
+	CardName          string     `gorm:"type:varchar(255)" json:"card_name"`
+	MaskedPan         string     `gorm:"type:varchar(255)" json:"masked_pan"`
+	MonobankID        string     `gorm:"type:varchar(255);unique" json:"monobank_id"`
+	MonobankAccountID string     `gorm:"type:varchar(255)" json:"monobank_account_id"`
+	Balance           int64      `gorm:"not null" json:"balance"`
+	CreditLimit       int64      `gorm:"not null;default:0" json:"credit_limit"`
+	CurrencyCode      int        `gorm:"not null" json:"currency_code"`
+	Type              string     `gorm:"type:varchar(50)" json:"type"`
+	IsManual          bool       `gorm:"not null;default:false" json:"is_manual"`
+	DefaultCategoryID *uuid.UUID `gorm:"type:uuid" json:"default_category_id"`
 }
 
 // Category represents a transaction category
@@ -99,3 +100,52 @@ type MonobankIntegration struct {
 	LastSync    time.Time `gorm:"not null" json:"last_sync"`
 	SyncError   *string   `gorm:"type:text" json:"sync_error"`
 }
+
+// MonobankReimportResult summarizes the reconciliation of a re-fetched Monobank statement window.
+// Cards skipped after hitting the Monobank rate limit can be re-imported one at a time later.
+// Truncated cards had more transactions than fit in one statement page and only their newest
+// transactions were reconciled; re-import them with a shorter window.
+type MonobankReimportResult struct {
+	From               time.Time         `json:"from"`
+	To                 time.Time         `json:"to"`
+	Fetched            int               `json:"fetched"`
+	Unchanged          int               `json:"unchanged"`
+	Added              []Transaction     `json:"added"`
+	Updated            []TransactionDiff `json:"updated"`
+	FailedCards        []uuid.UUID       `json:"failed_cards"`
+	SkippedCards       []uuid.UUID       `json:"skipped_cards"`
+	TruncatedCards     []uuid.UUID       `json:"truncated_cards"`
+	FailedTransactions []string          `json:"failed_transactions"` // Monobank IDs
+}
+
+// TransactionDiff lists the fields of a stored transaction that were corrected during a re-import
+type TransactionDiff struct {
+	TransactionID uuid.UUID     `json:"transaction_id"`
+	MonobankID    string        `json:"monobank_id"`
+	Changes       []FieldChange `json:"changes"`
+}
+
+// FieldChange represents a single changed field value
+type FieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// MaintenanceStatus represents the read-only maintenance mode state of the API
+type MaintenanceStatus struct {
+	Enabled    bool       `json:"enabled"`
+	Reason     string     `json:"reason,omitempty"`
+	Since      *time.Time `json:"since,omitempty"`
+	RetryAfter int        `json:"retry_after,omitempty"` // seconds
+}
+
+// MaintenanceState is the persisted maintenance mode switch shared by all server instances
+type MaintenanceState struct {
+	ID         int16      `gorm:"primaryKey"`
+	Enabled    bool       `gorm:"not null;default:false"`
+	Reason     string     `gorm:"type:text;not null;default:''"`
+	Since      *time.Time `gorm:"type:timestamptz"`
+	RetryAfter int        `gorm:"not null;default:0"` // seconds
+	UpdatedAt  time.Time  `gorm:"not null"`
+}
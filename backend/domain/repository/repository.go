@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -35,6 +36,7 @@ type CardRepository interface {
 	GetByUserID(ctx context.Context, userID uuid.UUID) ([]entity.Card, error)
 	GetByMonobankAccountID(ctx context.Context, accountID string) (*entity.Card, error)
 	Update(ctx context.Context, card *entity.Card) error
+	UpdateDefaultCategory(ctx context.Context, id uuid.UUID, categoryID *uuid.UUID) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
@@ -45,6 +47,7 @@ type TransactionRepository interface {
 	GetByCardID(ctx context.Context, cardID uuid.UUID, limit, offset int) ([]entity.Transaction, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]entity.Transaction, error)
 	GetByMonobankID(ctx context.Context, monobankID string) (*entity.Transaction, error)
+	GetByUserIDInPeriod(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]entity.Transaction, error)
 	Update(ctx context.Context, transaction *entity.Transaction) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	Search(ctx context.Context, userID uuid.UUID, params entity.TransactionSearchParams, limit, offset int) ([]entity.Transaction, error)
@@ -77,3 +80,9 @@ type RefreshTokenRepository interface {
 	DeleteExpired(ctx context.Context) error
 	Update(ctx context.Context, token *entity.RefreshToken) error
 }
+
+// MaintenanceRepository defines the interface for persisting the maintenance mode switch
+type MaintenanceRepository interface {
+	Get(ctx context.Context) (*entity.MaintenanceState, error)
+	Save(ctx context.Context, state *entity.MaintenanceState) error
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -17,6 +18,7 @@ type Factory interface {
 	NewCategoryService() CategoryService
 	NewMonobankService() MonobankService
 	NewAuthService() AuthService
+	NewInsightsService() InsightsService
 }
 
 // UserService handles user-related business logic
@@ -35,6 +37,7 @@ type CardService interface {
 	GetByUserID(ctx context.Context, userID uuid.UUID) ([]entity.Card, error)
 	Update(ctx context.Context, card *entity.Card) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	SetDefaultCategory(ctx context.Context, userID, cardID uuid.UUID, categoryID *uuid.UUID) (*entity.Card, error)
 }
 
 // TransactionService handles transaction-related business logic
@@ -67,6 +70,7 @@ type MonobankService interface {
 	Connect(ctx context.Context, userID uuid.UUID, token string) error
 	Disconnect(ctx context.Context, userID uuid.UUID) error
 	SyncUserData(ctx context.Context, userID uuid.UUID) error
+	Reimport(ctx context.Context, userID uuid.UUID, cardID *uuid.UUID, from, to time.Time) (*entity.MonobankReimportResult, error)
 	HandleWebhook(ctx context.Context, data []byte) error
 	GetStatus(ctx context.Context, userID uuid.UUID) (*entity.MonobankIntegration, error)
 	SetHTTPClient(client interface {
@@ -78,8 +82,8 @@ type MonobankService interface {
 type AuthService interface {
 	Register(ctx context.Context, req *entity.RegisterRequest) (*entity.RegisterResponse, error)
 	Login(ctx context.Context, req *entity.LoginRequest) (*entity.LoginResponse, error)
-	RefreshToken(ctx context.Context, token string) (*entity.AuthToken, error)
-	Logout(ctx context.Context, userID uuid.UUID, token string) error
+	RefreshToken(ctx context.Context, token, userAgent, ip string) (*entity.AuthToken, error)
+	Logout(ctx context.Context, userID uuid.UUID, token, userAgent, ip string) error
 	ValidateToken(ctx context.Context, token string) (*entity.Claims, error)
 	HashPassword(password string) (string, error)
 	VerifyPassword(password, hash string) error
@@ -87,3 +91,26 @@ type AuthService interface {
 	RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error
 	GetActiveTokens(ctx context.Context, userID uuid.UUID) ([]entity.RefreshToken, error)
 }
+
+// InsightsService computes spending insights shared by analytics features
+type InsightsService interface {
+	GetBaselines(ctx context.Context, userID uuid.UUID) (*entity.SpendingBaselines, error)
+}
+
+// PasswordHasher hashes and verifies user passwords
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Verify(password, hash string) error
+	NeedsRehash(hash string) bool
+}
+
+// AuditLogger records security-relevant events for export to audit sinks.
+// Implementations must not block the caller.
+type AuditLogger interface {
+	Record(event entity.AuditEvent)
+}
+
+// MaintenanceSwitch reports whether the API is in read-only maintenance mode
+type MaintenanceSwitch interface {
+	Enabled() bool
+}
@@ -0,0 +1,167 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"cashone/domain/entity"
+	"cashone/pkg/config"
+)
+
+// Supported export formats
+const (
+	FormatJSONLines = "jsonl"
+	FormatCEF       = "cef"
+)
+
+// Supported export outputs
+const (
+	OutputFile   = "file"
+	OutputSyslog = "syslog"
+)
+
+// sink writes formatted audit events to their destination
+type sink interface {
+	Write(line []byte, severity int) error
+	Close() error
+}
+
+// fileSink appends formatted events to a file
+type fileSink struct {
+	file *os.File
+}
+
+func (s *fileSink) Write(line []byte, _ int) error {
+	_, err := s.file.Write(line)
+	return err
+}
+
+func (s *fileSink) Close() error {
+	return s.file.Close()
+}
+
+// Exporter streams audit events to a file or syslog endpoint in a SIEM format.
+// Events are buffered in memory; when the buffer is full new events are dropped
+// and counted, so a slow sink never blocks request handling.
+// A disabled exporter ignores all events.
+type Exporter struct {
+	cfg     *config.AuditConfig
+	log     *zap.SugaredLogger
+	events  chan entity.AuditEvent
+	format  func(entity.AuditEvent) ([]byte, error)
+	sink    sink
+	dropped atomic.Int64
+	done    chan struct{}
+}
+
+// NewExporter creates a new audit exporter from the logger's audit configuration
+func NewExporter(cfg *config.AuditConfig, log *zap.SugaredLogger) (*Exporter, error) {
+	e := &Exporter{cfg: cfg, log: log, done: make(chan struct{})}
+	if !cfg.Enabled {
+		close(e.done)
+		return e, nil
+	}
+
+	switch cfg.Format {
+	case FormatJSONLines:
+		e.format = formatJSONLine
+	case FormatCEF:
+		e.format = formatCEF
+	default:
+		return nil, fmt.Errorf("unsupported audit format %q", cfg.Format)
+	}
+
+	switch cfg.Output {
+	case OutputFile:
+		file, err := os.OpenFile(cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open audit log %s: %w", cfg.Path, err)
+		}
+		e.sink = &fileSink{file: file}
+	case OutputSyslog:
+		e.sink = newSyslogSink(cfg.SyslogNetwork, cfg.SyslogAddress)
+	default:
+		return nil, fmt.Errorf("unsupported audit output %q", cfg.Output)
+	}
+
+	e.events = make(chan entity.AuditEvent, cfg.BufferSize)
+	return e, nil
+}
+
+// Record queues an audit event for export without blocking
+func (e *Exporter) Record(event entity.AuditEvent) {
+	if e.events == nil {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+
+	select {
+	case e.events <- event:
+	default:
+		e.dropped.Add(1)
+	}
+}
+
+// Run writes queued events until the context is cancelled, then drains the buffer and closes the sink
+func (e *Exporter) Run(ctx context.Context) {
+	if e.events == nil {
+		return
+	}
+	defer close(e.done)
+	defer e.sink.Close()
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event := <-e.events:
+			e.write(event)
+		case <-ticker.C:
+			e.reportDropped()
+		case <-ctx.Done():
+			for {
+				select {
+				case event := <-e.events:
+					e.write(event)
+				default:
+					e.reportDropped()
+					return
+				}
+			}
+		}
+	}
+}
+
+// Wait blocks until Run has flushed all queued events
+func (e *Exporter) Wait() {
+	<-e.done
+}
+
+func (e *Exporter) write(event entity.AuditEvent) {
+	line, err := e.format(event)
+	if err != nil {
+		e.log.Errorw("Failed to format audit event", "error", err, "type", event.Type)
+		return
+	}
+	if err := e.sink.Write(line, event.Severity); err != nil {
+		e.dropped.Add(1)
+		e.log.Errorw("Failed to export audit event", "error", err, "type", event.Type)
+	}
+}
+
+func (e *Exporter) reportDropped() {
+	if dropped := e.dropped.Swap(0); dropped > 0 {
+		e.log.Warnw("Audit events were dropped because the export buffer was full or the sink failed",
+			"dropped", dropped,
+			"buffer_size", e.cfg.BufferSize,
+		)
+	}
+}
@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cashone/domain/entity"
+	"cashone/pkg/version"
+)
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+// formatJSONLine encodes the event as a single JSON object followed by a newline
+func formatJSONLine(event entity.AuditEvent) ([]byte, error) {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+// formatCEF encodes the event in ArcSight Common Event Format followed by a newline
+func formatCEF(event entity.AuditEvent) ([]byte, error) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "CEF:0|%s|%s|%s|%s|%s|%d|",
+		cefHeaderEscaper.Replace("Lotarcc"),
+		cefHeaderEscaper.Replace("CashOne"),
+		cefHeaderEscaper.Replace(version.Version),
+		cefHeaderEscaper.Replace(event.Type),
+		cefHeaderEscaper.Replace(cefName(event)),
+		event.Severity,
+	)
+
+	extensions := [][2]string{
+		{"rt", strconv.FormatInt(event.Time.UnixMilli(), 10)},
+		{"outcome", event.Outcome},
+		{"act", event.Type},
+	}
+	if event.UserID != nil {
+		extensions = append(extensions, [2]string{"suid", event.UserID.String()})
+	}
+	optional := [][2]string{
+		{"suser", event.Email},
+		{"src", event.IP},
+		{"requestClientApplication", event.UserAgent},
+		{"msg", event.Message},
+	}
+	for _, ext := range optional {
+		if ext[1] != "" {
+			extensions = append(extensions, ext)
+		}
+	}
+
+	for i, ext := range extensions {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(ext[0])
+		b.WriteByte('=')
+		b.WriteString(cefExtensionEscaper.Replace(ext[1]))
+	}
+	b.WriteByte('\n')
+
+	return []byte(b.String()), nil
+}
+
+// cefName returns a human readable event name for the CEF header
+func cefName(event entity.AuditEvent) string {
+	switch event.Type {
+	case entity.AuditUserRegistered:
+		return "User registered"
+	case entity.AuditLoginSucceeded:
+		return "Login succeeded"
+	case entity.AuditLoginFailed:
+		return "Login failed"
+	case entity.AuditTokenRefreshFailed:
+		return "Token refresh failed"
+	case entity.AuditLogout:
+		return "Logout"
+	case entity.AuditTokensRevoked:
+		return "All sessions revoked"
+	case entity.AuditPasswordRehashed:
+		return "Password hash upgraded"
+	case entity.AuditMaintenanceToggled:
+		return "Maintenance mode changed"
+	case entity.AuditAdminAccessDenied:
+		return "Admin access denied"
+	default:
+		return event.Type
+	}
+}
@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// syslogFacility is security/authorization messages (authpriv)
+	syslogFacility = 10
+	syslogAppName  = "cashone"
+	syslogTimeout  = 5 * time.Second
+)
+
+// Syslog severities used for audit events (RFC 5424)
+const (
+	syslogSeverityCritical      = 2
+	syslogSeverityWarning       = 4
+	syslogSeverityNotice        = 5
+	syslogSeverityInformational = 6
+)
+
+// syslogSink sends each written line as an RFC 5424 message.
+// The connection is dialed lazily and re-established after a write failure.
+type syslogSink struct {
+	network  string
+	address  string
+	hostname string
+	conn     net.Conn
+}
+
+func newSyslogSink(network, address string) *syslogSink {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &syslogSink{
+		network:  network,
+		address:  address,
+		hostname: hostname,
+	}
+}
+
+// Write frames the line as a syslog message with the event's severity and sends it,
+// reconnecting once on failure
+func (s *syslogSink) Write(line []byte, severity int) error {
+	msg := s.frame(strings.TrimRight(string(line), "\n"), syslogSeverity(severity))
+
+	for attempt := 0; attempt < 2; attempt++ {
+		if s.conn == nil {
+			conn, err := net.DialTimeout(s.network, s.address, syslogTimeout)
+			if err != nil {
+				return fmt.Errorf("failed to connect to syslog %s://%s: %w", s.network, s.address, err)
+			}
+			s.conn = conn
+		}
+
+		if err := s.conn.SetWriteDeadline(time.Now().Add(syslogTimeout)); err == nil {
+			if _, err = s.conn.Write(msg); err == nil {
+				return nil
+			}
+		}
+		s.conn.Close()
+		s.conn = nil
+	}
+
+	return fmt.Errorf("failed to write to syslog %s://%s", s.network, s.address)
+}
+
+// Close closes the underlying connection
+func (s *syslogSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// frame builds an RFC 5424 message. Stream transports use octet counting (RFC 6587).
+func (s *syslogSink) frame(content string, severity int) []byte {
+	msg := fmt.Sprintf("<%d>1 %s %s %s %d - - %s",
+		syslogFacility*8+severity,
+		time.Now().UTC().Format(time.RFC3339Nano),
+		s.hostname,
+		syslogAppName,
+		os.Getpid(),
+		content,
+	)
+	if strings.HasPrefix(s.network, "udp") {
+		return []byte(msg)
+	}
+	return []byte(fmt.Sprintf("%d %s", len(msg), msg))
+}
+
+// syslogSeverity maps a CEF severity (0-10) to a syslog severity
+func syslogSeverity(cefSeverity int) int {
+	switch {
+	case cefSeverity >= 9:
+		return syslogSeverityCritical
+	case cefSeverity >= 7:
+		return syslogSeverityWarning
+	case cefSeverity >= 4:
+		return syslogSeverityNotice
+	default:
+		return syslogSeverityInformational
+	}
+}
@@ -2,18 +2,33 @@ package database
 
 import (
 	"cashone/domain/entity"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"gorm.io/gorm"
 )
 
+// migrationLockKey identifies the Postgres advisory lock held while migrations run
+const migrationLockKey int64 = 0x636173686f6e65 // "cashone"
+
 // MigrationManager handles database migrations
 type MigrationManager struct {
-	db *gorm.DB
+	db          *gorm.DB
+	snapshotter *Snapshotter
+}
+
+// migrationFile describes an up migration found on disk
+type migrationFile struct {
+	Version  string
+	Path     string
+	Checksum string
 }
 
 // NewMigrationManager creates a new migration manager
@@ -21,117 +36,218 @@ func NewMigrationManager(db *gorm.DB) *MigrationManager {
 	return &MigrationManager{db: db}
 }
 
-// MigrateUp runs all migrations
-func (m *MigrationManager) MigrateUp() error {
-	// Create migrations table if it doesn't exist
-	err := m.db.AutoMigrate(&entity.Migration{})
-	if err != nil {
-		return fmt.Errorf("failed to create migrations table: %v", err)
-	}
-
-	// Get all SQL migration files
-	files, err := m.getMigrationFiles()
-	if err != nil {
-		return fmt.Errorf("failed to get migration files: %v", err)
-	}
+// SetSnapshotter enables a safety snapshot of the database before migrations are applied or rolled back
+func (m *MigrationManager) SetSnapshotter(snapshotter *Snapshotter) {
+	m.snapshotter = snapshotter
+}
 
-	// Run each migration in transaction
-	for _, file := range files {
-		version := strings.Split(filepath.Base(file), "_")[0]
+// MigrateUp runs all pending migrations in version order while holding the migration lock
+func (m *MigrationManager) MigrateUp() error {
+	return m.withLock(func(conn *gorm.DB) error {
+		// Create migrations table if it doesn't exist
+		if err := conn.AutoMigrate(&entity.Migration{}); err != nil {
+			return fmt.Errorf("failed to create migrations table: %v", err)
+		}
 
-		// Check if migration was already applied
-		var count int64
-		m.db.Model(&entity.Migration{}).Where("version = ?", version).Count(&count)
-		if count > 0 {
-			continue
+		// Load migration files in version order
+		files, err := m.loadMigrationFiles()
+		if err != nil {
+			return fmt.Errorf("failed to get migration files: %v", err)
 		}
 
-		// Read migration file
-		content, err := os.ReadFile(file)
+		applied, err := m.getAppliedMigrations(conn)
 		if err != nil {
-			return fmt.Errorf("failed to read migration file %s: %v", file, err)
+			return err
 		}
 
-		// Begin transaction
-		tx := m.db.Begin()
+		// Verify applied migrations were not modified and collect pending ones
+		var pending []migrationFile
+		var lastApplied string
+		for _, file := range files {
+			migration, ok := applied[file.Version]
+			if !ok {
+				pending = append(pending, file)
+				continue
+			}
+			lastApplied = file.Version
+
+			if migration.Checksum == "" {
+				// Migrations applied before checksums were tracked are adopted as-is
+				if err := conn.Model(&migration).Update("checksum", file.Checksum).Error; err != nil {
+					return fmt.Errorf("failed to store checksum for migration %s: %v", file.Version, err)
+				}
+				continue
+			}
+			if migration.Checksum != file.Checksum {
+				return fmt.Errorf("checksum mismatch for applied migration %s: recorded %s, file %s has %s",
+					file.Version, migration.Checksum, file.Path, file.Checksum)
+			}
+		}
 
-		// Execute migration
-		if err := tx.Exec(string(content)).Error; err != nil {
-			tx.Rollback()
-			return fmt.Errorf("failed to execute migration %s: %v", file, err)
+		if len(pending) == 0 {
+			return nil
 		}
 
-		// Record migration
-		if err := tx.Create(&entity.Migration{Version: version}).Error; err != nil {
-			tx.Rollback()
-			return fmt.Errorf("failed to record migration %s: %v", file, err)
+		if lastApplied != "" && compareVersions(pending[0].Version, lastApplied) < 0 {
+			log.Printf("Warning: migration %s is older than the last applied migration %s\n", pending[0].Version, lastApplied)
 		}
 
-		// Commit transaction
-		if err := tx.Commit().Error; err != nil {
-			return fmt.Errorf("failed to commit migration %s: %v", file, err)
+		// Take a safety snapshot before touching the schema
+		if m.snapshotter != nil {
+			if _, err := m.snapshotter.Create("before_" + pending[0].Version); err != nil {
+				return fmt.Errorf("failed to create pre-migration snapshot: %v", err)
+			}
 		}
 
-		log.Printf("Applied migration: %s\n", version)
-	}
+		// Run each migration in transaction
+		for _, file := range pending {
+			// Read migration file
+			content, err := os.ReadFile(file.Path)
+			if err != nil {
+				return fmt.Errorf("failed to read migration file %s: %v", file.Path, err)
+			}
 
-	return nil
+			// Begin transaction
+			tx := conn.Begin()
+
+			// Execute migration
+			if err := tx.Exec(string(content)).Error; err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to execute migration %s: %v", file.Path, err)
+			}
+
+			// Record migration
+			if err := tx.Create(&entity.Migration{Version: file.Version, Checksum: file.Checksum}).Error; err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to record migration %s: %v", file.Path, err)
+			}
+
+			// Commit transaction
+			if err := tx.Commit().Error; err != nil {
+				return fmt.Errorf("failed to commit migration %s: %v", file.Path, err)
+			}
+
+			log.Printf("Applied migration: %s\n", file.Version)
+		}
+
+		return nil
+	})
 }
 
-// MigrateDown rolls back all migrations
+// MigrateDown rolls back all migrations while holding the migration lock
 func (m *MigrationManager) MigrateDown() error {
-	var migrations []entity.Migration
-	if err := m.db.Order("version DESC").Find(&migrations).Error; err != nil {
-		return fmt.Errorf("failed to get applied migrations: %v", err)
-	}
-
-	for _, migration := range migrations {
-		// Find corresponding down migration file
-		downFile := filepath.Join(m.getMigrationsDir(), fmt.Sprintf("%s_down.sql", migration.Version))
-		if _, err := os.Stat(downFile); os.IsNotExist(err) {
-			return fmt.Errorf("down migration file not found for version %s", migration.Version)
+	return m.withLock(func(conn *gorm.DB) error {
+		var migrations []entity.Migration
+		if err := conn.Find(&migrations).Error; err != nil {
+			return fmt.Errorf("failed to get applied migrations: %v", err)
 		}
+		if len(migrations) == 0 {
+			return nil
+		}
+		sort.Slice(migrations, func(i, j int) bool {
+			return compareVersions(migrations[i].Version, migrations[j].Version) > 0
+		})
 
-		// Read down migration file
-		content, err := os.ReadFile(downFile)
+		files, err := m.loadMigrationFiles()
 		if err != nil {
-			return fmt.Errorf("failed to read down migration %s: %v", downFile, err)
+			return fmt.Errorf("failed to get migration files: %v", err)
+		}
+		upFiles := make(map[string]string, len(files))
+		for _, file := range files {
+			upFiles[file.Version] = file.Path
 		}
 
-		// Begin transaction
-		tx := m.db.Begin()
-
-		// Execute down migration
-		if err := tx.Exec(string(content)).Error; err != nil {
-			tx.Rollback()
-			return fmt.Errorf("failed to execute down migration %s: %v", downFile, err)
+		// Resolve all down migration files before anything is rolled back
+		downFiles := make(map[string]string, len(migrations))
+		for _, migration := range migrations {
+			// Find corresponding down migration file
+			upFile, ok := upFiles[migration.Version]
+			if !ok {
+				return fmt.Errorf("migration file not found for version %s", migration.Version)
+			}
+			downFile := strings.TrimSuffix(upFile, ".sql") + "_down.sql"
+			if _, err := os.Stat(downFile); os.IsNotExist(err) {
+				return fmt.Errorf("down migration file not found for version %s", migration.Version)
+			}
+			downFiles[migration.Version] = downFile
 		}
 
-		// Remove migration record
-		if err := tx.Delete(&migration).Error; err != nil {
-			tx.Rollback()
-			return fmt.Errorf("failed to remove migration record %s: %v", migration.Version, err)
+		// Take a safety snapshot before dropping any data
+		if m.snapshotter != nil {
+			if _, err := m.snapshotter.Create("before_down_" + migrations[0].Version); err != nil {
+				return fmt.Errorf("failed to create pre-rollback snapshot: %v", err)
+			}
 		}
 
-		// Commit transaction
-		if err := tx.Commit().Error; err != nil {
-			return fmt.Errorf("failed to commit down migration %s: %v", downFile, err)
+		for _, migration := range migrations {
+			downFile := downFiles[migration.Version]
+
+			// Read down migration file
+			content, err := os.ReadFile(downFile)
+			if err != nil {
+				return fmt.Errorf("failed to read down migration %s: %v", downFile, err)
+			}
+
+			// Begin transaction
+			tx := conn.Begin()
+
+			// Execute down migration
+			if err := tx.Exec(string(content)).Error; err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to execute down migration %s: %v", downFile, err)
+			}
+
+			// Remove migration record
+			if err := tx.Delete(&migration).Error; err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to remove migration record %s: %v", migration.Version, err)
+			}
+
+			// Commit transaction
+			if err := tx.Commit().Error; err != nil {
+				return fmt.Errorf("failed to commit down migration %s: %v", downFile, err)
+			}
+
+			log.Printf("Rolled back migration: %s\n", migration.Version)
 		}
 
-		log.Printf("Rolled back migration: %s\n", migration.Version)
+		return nil
+	})
+}
+
+// RollbackToSnapshot restores the database from a snapshot taken before a migration run
+// while holding the migration lock. An empty path or "latest" selects the most recent snapshot.
+func (m *MigrationManager) RollbackToSnapshot(path string) error {
+	if m.snapshotter == nil {
+		return fmt.Errorf("snapshots are not configured")
 	}
 
-	return nil
+	if path == "" || path == "latest" {
+		latest, err := m.snapshotter.Latest()
+		if err != nil {
+			return fmt.Errorf("failed to find latest snapshot: %v", err)
+		}
+		path = latest
+	}
+
+	return m.withLock(func(conn *gorm.DB) error {
+		if err := m.snapshotter.Restore(path); err != nil {
+			return fmt.Errorf("failed to restore snapshot %s: %v", path, err)
+		}
+
+		log.Printf("Rolled back to snapshot: %s\n", path)
+		return nil
+	})
 }
 
 // Status prints the status of all migrations
 func (m *MigrationManager) Status() error {
-	var migrations []entity.Migration
-	if err := m.db.Order("version ASC").Find(&migrations).Error; err != nil {
-		return fmt.Errorf("failed to get migrations: %v", err)
+	applied, err := m.getAppliedMigrations(m.db)
+	if err != nil {
+		return err
 	}
 
-	files, err := m.getMigrationFiles()
+	files, err := m.loadMigrationFiles()
 	if err != nil {
 		return fmt.Errorf("failed to get migration files: %v", err)
 	}
@@ -139,22 +255,63 @@ func (m *MigrationManager) Status() error {
 	fmt.Println("Migration Status:")
 	fmt.Println("================")
 
-	appliedVersions := make(map[string]bool)
-	for _, migration := range migrations {
-		appliedVersions[migration.Version] = true
-		fmt.Printf("[✓] %s (applied)\n", migration.Version)
-	}
-
 	for _, file := range files {
-		version := strings.Split(filepath.Base(file), "_")[0]
-		if !appliedVersions[version] {
-			fmt.Printf("[ ] %s (pending)\n", version)
+		migration, ok := applied[file.Version]
+		switch {
+		case !ok:
+			fmt.Printf("[ ] %s (pending)\n", file.Version)
+		case migration.Checksum != "" && migration.Checksum != file.Checksum:
+			fmt.Printf("[!] %s (applied, checksum mismatch)\n", file.Version)
+		default:
+			fmt.Printf("[✓] %s (applied)\n", file.Version)
 		}
+		delete(applied, file.Version)
+	}
+
+	missing := make([]string, 0, len(applied))
+	for version := range applied {
+		missing = append(missing, version)
+	}
+	sort.Slice(missing, func(i, j int) bool {
+		return compareVersions(missing[i], missing[j]) < 0
+	})
+	for _, version := range missing {
+		fmt.Printf("[?] %s (applied, file missing)\n", version)
 	}
 
 	return nil
 }
 
+// withLock runs fn on a single connection holding a Postgres advisory lock,
+// so concurrent instances cannot run migrations at the same time
+func (m *MigrationManager) withLock(fn func(conn *gorm.DB) error) error {
+	return m.db.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", migrationLockKey).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %v", err)
+		}
+		defer func() {
+			if err := conn.Exec("SELECT pg_advisory_unlock(?)", migrationLockKey).Error; err != nil {
+				log.Printf("Warning: failed to release migration lock: %v", err)
+			}
+		}()
+
+		return fn(conn)
+	})
+}
+
+func (m *MigrationManager) getAppliedMigrations(db *gorm.DB) (map[string]entity.Migration, error) {
+	var migrations []entity.Migration
+	if err := db.Find(&migrations).Error; err != nil {
+		return nil, fmt.Errorf("failed to get applied migrations: %v", err)
+	}
+
+	applied := make(map[string]entity.Migration, len(migrations))
+	for _, migration := range migrations {
+		applied[migration.Version] = migration
+	}
+	return applied, nil
+}
+
 func (m *MigrationManager) getMigrationsDir() string {
 	// Try to find the db/migrations directory relative to the current working directory
 	dir, err := os.Getwd()
@@ -200,3 +357,53 @@ func (m *MigrationManager) getMigrationFiles() ([]string, error) {
 	}
 	return files, nil
 }
+
+// loadMigrationFiles returns the up migrations sorted by version together with their checksums
+func (m *MigrationManager) loadMigrationFiles() ([]migrationFile, error) {
+	paths, err := m.getMigrationFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]migrationFile, 0, len(paths))
+	seen := make(map[string]string, len(paths))
+	for _, path := range paths {
+		version := strings.Split(filepath.Base(path), "_")[0]
+		if other, ok := seen[version]; ok {
+			return nil, fmt.Errorf("duplicate migration version %s: %s and %s", version, other, path)
+		}
+		seen[version] = path
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration file %s: %v", path, err)
+		}
+		sum := sha256.Sum256(content)
+
+		files = append(files, migrationFile{
+			Version:  version,
+			Path:     path,
+			Checksum: hex.EncodeToString(sum[:]),
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return compareVersions(files[i].Version, files[j].Version) < 0
+	})
+	return files, nil
+}
+
+// compareVersions orders numeric versions by value (so 004 < 20240101120000) and falls back to string order
+func compareVersions(a, b string) int {
+	na, errA := strconv.ParseUint(a, 10, 64)
+	nb, errB := strconv.ParseUint(b, 10, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case na < nb:
+			return -1
+		case na > nb:
+			return 1
+		}
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,121 @@
+package database
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"cashone/pkg/config"
+)
+
+const snapshotExtension = ".dump"
+
+// resetSchemaSQL drops everything in the public schema, including objects created after the
+// snapshot was taken, which pg_restore --clean would leave behind
+const resetSchemaSQL = "DROP SCHEMA IF EXISTS public CASCADE; CREATE SCHEMA public;"
+
+// Snapshotter creates and restores logical database backups using pg_dump and pg_restore
+type Snapshotter struct {
+	cfg *config.DatabaseConfig
+	dir string
+}
+
+// NewSnapshotter creates a new snapshotter that stores backups in the given directory
+func NewSnapshotter(cfg *config.DatabaseConfig, dir string) *Snapshotter {
+	return &Snapshotter{cfg: cfg, dir: dir}
+}
+
+// Create writes a full logical backup of the database and returns the path to the snapshot file
+func (s *Snapshotter) Create(label string) (string, error) {
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return "", fmt.Errorf("failed to create snapshot directory %s: %v", s.dir, err)
+	}
+
+	name := fmt.Sprintf("%s_%s", s.cfg.Name, time.Now().UTC().Format("20060102150405"))
+	if label != "" {
+		name = fmt.Sprintf("%s_%s", name, label)
+	}
+	path := filepath.Join(s.dir, name+snapshotExtension)
+
+	args := append(s.connectionArgs(), "--format=custom", "--file="+path)
+	if output, err := s.command("pg_dump", args...).CombinedOutput(); err != nil {
+		os.Remove(path)
+		return "", fmt.Errorf("pg_dump failed: %v: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	log.Printf("Created database snapshot: %s\n", path)
+	return path, nil
+}
+
+// Restore replaces the public schema with the given snapshot. The snapshot is rendered to SQL
+// by pg_restore first and then applied by psql together with the schema reset in a single
+// transaction, so a failed restore leaves the database untouched.
+func (s *Snapshotter) Restore(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("snapshot %s is not accessible: %v", path, err)
+	}
+
+	script, err := os.CreateTemp("", "cashone_restore_*.sql")
+	if err != nil {
+		return fmt.Errorf("failed to create restore script: %v", err)
+	}
+	script.Close()
+	defer os.Remove(script.Name())
+
+	if output, err := s.command("pg_restore", "--no-owner", "--file="+script.Name(), path).CombinedOutput(); err != nil {
+		return fmt.Errorf("pg_restore failed, database was not changed: %v: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	args := append(s.connectionArgs(),
+		"--no-psqlrc",
+		"--quiet",
+		"--set=ON_ERROR_STOP=1",
+		"--single-transaction",
+		"--command="+resetSchemaSQL,
+		"--file="+script.Name(),
+	)
+	if output, err := s.command("psql", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("psql failed, database was not changed: %v: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	log.Printf("Restored database snapshot: %s\n", path)
+	return nil
+}
+
+// Latest returns the path of the most recent snapshot in the snapshot directory
+func (s *Snapshotter) Latest() (string, error) {
+	files, err := filepath.Glob(filepath.Join(s.dir, s.cfg.Name+"_*"+snapshotExtension))
+	if err != nil {
+		return "", fmt.Errorf("failed to list snapshots: %v", err)
+	}
+	if len(files) == 0 {
+		return "", fmt.Errorf("no snapshots found in %s", s.dir)
+	}
+
+	// Snapshot names embed a sortable UTC timestamp right after the database name
+	sort.Strings(files)
+	return files[len(files)-1], nil
+}
+
+func (s *Snapshotter) connectionArgs() []string {
+	return []string{
+		"--host=" + s.cfg.Host,
+		"--port=" + s.cfg.Port,
+		"--username=" + s.cfg.User,
+		"--dbname=" + s.cfg.Name,
+	}
+}
+
+func (s *Snapshotter) command(name string, args ...string) *exec.Cmd {
+	cmd := exec.Command(name, args...)
+	cmd.Env = append(os.Environ(), "PGPASSWORD="+s.cfg.Password)
+	if s.cfg.SSLMode != "" {
+		cmd.Env = append(cmd.Env, "PGSSLMODE="+s.cfg.SSLMode)
+	}
+	return cmd
+}
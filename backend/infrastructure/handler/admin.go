@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"cashone/domain/entity"
+	"cashone/domain/service"
+	"cashone/infrastructure/middleware"
+)
+
+// AdminHandler handles HTTP requests for operator endpoints
+type AdminHandler struct {
+	log         *zap.SugaredLogger
+	maintenance *middleware.MaintenanceMode
+	audit       service.AuditLogger
+}
+
+// NewAdminHandler creates a new admin handler and registers routes
+func NewAdminHandler(
+	e *echo.Echo,
+	log *zap.SugaredLogger,
+	maintenance *middleware.MaintenanceMode,
+	adminMiddleware *middleware.AdminMiddleware,
+	audit service.AuditLogger,
+) *AdminHandler {
+	handler := &AdminHandler{
+		log:         log,
+		maintenance: maintenance,
+		audit:       audit,
+	}
+
+	// All admin routes require the admin token
+	admin := e.Group("/api/v1/admin", adminMiddleware.Authorize)
+	admin.GET("/maintenance", handler.GetMaintenance)
+	admin.PUT("/maintenance", handler.SetMaintenance)
+
+	return handler
+}
+
+// GetMaintenance godoc
+// @Summary Get maintenance mode
+// @Description Get the current maintenance mode state
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Token header string true "Admin token"
+// @Success 200 {object} entity.MaintenanceStatus
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Router /api/v1/admin/maintenance [get]
+func (h *AdminHandler) GetMaintenance(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.maintenance.Status())
+}
+
+// SetMaintenance godoc
+// @Summary Toggle maintenance mode
+// @Description Enable or disable read-only maintenance mode for all server instances. Mutating requests are rejected with 503 while it is enabled. The switch is stored in the database and survives restarts; other instances pick it up within the configured poll interval.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Token header string true "Admin token"
+// @Param maintenance body setMaintenanceRequest true "Maintenance settings"
+// @Success 200 {object} entity.MaintenanceStatus
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/admin/maintenance [put]
+func (h *AdminHandler) SetMaintenance(c echo.Context) error {
+	var req setMaintenanceRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+	if req.RetryAfter < 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "Retry-After must not be negative")
+	}
+
+	var err error
+	if req.Enabled {
+		err = h.maintenance.Enable(c.Request().Context(), req.Reason, time.Duration(req.RetryAfter)*time.Second)
+	} else {
+		err = h.maintenance.Disable(c.Request().Context())
+	}
+	if err != nil {
+		h.log.Errorw("Failed to update maintenance mode",
+			"error", err,
+			"enabled", req.Enabled,
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update maintenance mode")
+	}
+
+	h.audit.Record(entity.AuditEvent{
+		Type:      entity.AuditMaintenanceToggled,
+		Outcome:   entity.AuditOutcomeSuccess,
+		Severity:  entity.AuditSeverityNotice,
+		IP:        c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+		Message:   fmt.Sprintf("enabled=%t reason=%q", req.Enabled, req.Reason),
+	})
+
+	return c.JSON(http.StatusOK, h.maintenance.Status())
+}
+
+// setMaintenanceRequest represents the request body for toggling maintenance mode
+type setMaintenanceRequest struct {
+	Enabled    bool   `json:"enabled"`
+	Reason     string `json:"reason"`
+	RetryAfter int    `json:"retry_after"` // seconds
+}
@@ -100,6 +100,10 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Email and password are required")
 	}
 
+	// Client details come from the connection, not the body, so they can be trusted in audit logs
+	req.IP = c.RealIP()
+	req.UserAgent = c.Request().UserAgent()
+
 	// Login user
 	resp, err := h.authService.Login(c.Request().Context(), &req)
 	if err != nil {
@@ -141,8 +145,8 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Refresh token is required")
 	}
 
-	// Refresh token
-	token, err := h.authService.RefreshToken(c.Request().Context(), req.RefreshToken)
+	// Refresh token; client details come from the connection so they can be trusted in audit logs
+	token, err := h.authService.RefreshToken(c.Request().Context(), req.RefreshToken, c.Request().UserAgent(), c.RealIP())
 	if err != nil {
 		switch err {
 		case errors.ErrInvalidToken:
@@ -191,7 +195,7 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 	}
 
 	// Logout user
-	if err := h.authService.Logout(c.Request().Context(), claims.UserID, req.RefreshToken); err != nil {
+	if err := h.authService.Logout(c.Request().Context(), claims.UserID, req.RefreshToken, c.Request().UserAgent(), c.RealIP()); err != nil {
 		h.log.Errorw("Failed to logout user",
 			"error", err,
 			"user_id", claims.UserID,
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"cashone/domain/errors"
+	"cashone/domain/service"
+	"cashone/infrastructure/handler/response"
+	"cashone/infrastructure/middleware"
+)
+
+// CardHandler handles HTTP requests for card-related endpoints
+type CardHandler struct {
+	log         *zap.SugaredLogger
+	cardService service.CardService
+}
+
+// NewCardHandler creates a new card handler and registers routes
+func NewCardHandler(
+	e *echo.Echo,
+	log *zap.SugaredLogger,
+	cardService service.CardService,
+	authMiddleware *middleware.AuthMiddleware,
+) *CardHandler {
+	handler := &CardHandler{
+		log:         log,
+		cardService: cardService,
+	}
+
+	// All card routes require authentication
+	cards := e.Group("/api/v1/cards", authMiddleware.Authenticate)
+	cards.GET("", handler.List)
+	cards.PUT("/:id/default-category", handler.SetDefaultCategory)
+
+	return handler
+}
+
+// List godoc
+// @Summary List cards
+// @Description Get list of cards for the authenticated user
+// @Tags cards
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Response{data=[]entity.Card}
+// @Failure 401 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/cards [get]
+// @Security Bearer
+func (h *CardHandler) List(c echo.Context) error {
+	userIDStr := middleware.GetUserIDFromContext(c)
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, response.NewErrorResponse("UNAUTHORIZED", "Invalid user ID", err.Error()))
+	}
+
+	cards, err := h.cardService.GetByUserID(c.Request().Context(), userID)
+	if err != nil {
+		h.log.Errorw("Failed to get cards",
+			"error", err,
+			"user_id", userID,
+		)
+		return c.JSON(http.StatusInternalServerError, response.NewErrorResponse("INTERNAL_ERROR", "Failed to get cards", ""))
+	}
+
+	return c.JSON(http.StatusOK, response.NewResponse("Cards retrieved successfully", cards))
+}
+
+// SetDefaultCategory godoc
+// @Summary Set card default category
+// @Description Set the category applied to uncategorized transactions of the card. Send null to clear it.
+// @Tags cards
+// @Accept json
+// @Produce json
+// @Param id path string true "Card ID"
+// @Param category body setDefaultCategoryRequest true "Default category"
+// @Success 200 {object} response.Response{data=entity.Card}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/cards/{id}/default-category [put]
+// @Security Bearer
+func (h *CardHandler) SetDefaultCategory(c echo.Context) error {
+	userIDStr := middleware.GetUserIDFromContext(c)
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, response.NewErrorResponse("UNAUTHORIZED", "Invalid user ID", err.Error()))
+	}
+
+	cardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, response.NewErrorResponse("INVALID_ID", "Invalid card ID", err.Error()))
+	}
+
+	var req setDefaultCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, response.NewErrorResponse("INVALID_REQUEST", "Invalid request body", err.Error()))
+	}
+
+	card, err := h.cardService.SetDefaultCategory(c.Request().Context(), userID, cardID, req.CategoryID)
+	if err != nil {
+		switch err {
+		case errors.ErrCardNotFound:
+			return c.JSON(http.StatusNotFound, response.NewErrorResponse("NOT_FOUND", "Card not found", ""))
+		case errors.ErrCategoryNotFound:
+			return c.JSON(http.StatusBadRequest, response.NewErrorResponse("INVALID_CATEGORY", "Category not found", ""))
+		default:
+			h.log.Errorw("Failed to set card default category",
+				"error", err,
+				"card_id", cardID,
+				"user_id", userID,
+			)
+			return c.JSON(http.StatusInternalServerError, response.NewErrorResponse("INTERNAL_ERROR", "Failed to set card default category", ""))
+		}
+	}
+
+	return c.JSON(http.StatusOK, response.NewResponse("Card default category updated successfully", card))
+}
+
+type setDefaultCategoryRequest struct {
+	CategoryID *uuid.UUID `json:"category_id"`
+}
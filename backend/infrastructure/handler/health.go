@@ -8,8 +8,10 @@ import (
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 
+	"cashone/domain/entity"
 	"cashone/domain/repository"
 	"cashone/domain/service"
+	"cashone/infrastructure/middleware"
 	"cashone/pkg/version"
 )
 
@@ -18,6 +20,7 @@ type HealthHandler struct {
 	log            *zap.SugaredLogger
 	repoFactory    repository.Factory
 	serviceFactory service.Factory
+	maintenance    *middleware.MaintenanceMode
 }
 
 // NewHealthHandler creates a new health check handler
@@ -26,11 +29,13 @@ func NewHealthHandler(
 	log *zap.SugaredLogger,
 	repoFactory repository.Factory,
 	serviceFactory service.Factory,
+	maintenance *middleware.MaintenanceMode,
 ) *HealthHandler {
 	handler := &HealthHandler{
 		log:            log,
 		repoFactory:    repoFactory,
 		serviceFactory: serviceFactory,
+		maintenance:    maintenance,
 	}
 
 	e.GET("/health", handler.Check)
@@ -56,13 +61,15 @@ func (h *HealthHandler) Check(c echo.Context) error {
 	dbErr := db.Ping(c.Request().Context())
 
 	healthData := struct {
-		Status    string `json:"status"`
-		Database  string `json:"database"`
-		Version   string `json:"version"`
-		Timestamp string `json:"timestamp"`
+		Status      string                   `json:"status"`
+		Database    string                   `json:"database"`
+		Version     string                   `json:"version"`
+		Timestamp   string                   `json:"timestamp"`
+		Maintenance entity.MaintenanceStatus `json:"maintenance"`
 	}{
-		Version:   versionInfo.Version,
-		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Version:     versionInfo.Version,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Maintenance: h.maintenance.Status(),
 	}
 
 	if dbErr != nil {
@@ -78,6 +85,7 @@ func (h *HealthHandler) Check(c echo.Context) error {
 		"version", healthData.Version,
 		"goroutines", runtime.NumGoroutine(),
 		"database", healthData.Database,
+		"maintenance", healthData.Maintenance.Enabled,
 	)
 
 	return c.JSON(http.StatusOK, healthData)
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"cashone/domain/service"
+	"cashone/infrastructure/handler/response"
+	"cashone/infrastructure/middleware"
+)
+
+// InsightsHandler handles HTTP requests for spending insight endpoints
+type InsightsHandler struct {
+	log             *zap.SugaredLogger
+	insightsService service.InsightsService
+}
+
+// NewInsightsHandler creates a new insights handler and registers routes
+func NewInsightsHandler(
+	e *echo.Echo,
+	log *zap.SugaredLogger,
+	insightsService service.InsightsService,
+	authMiddleware *middleware.AuthMiddleware,
+) *InsightsHandler {
+	handler := &InsightsHandler{
+		log:             log,
+		insightsService: insightsService,
+	}
+
+	// All insights routes require authentication
+	insights := e.Group("/api/v1/insights", authMiddleware.Authenticate)
+	insights.GET("/baselines", handler.Baselines)
+
+	return handler
+}
+
+// Baselines godoc
+// @Summary Get spending baselines
+// @Description Get the user's typical monthly spend per category and typical transaction size per MCC and merchant (rolling 6-month medians, one baseline per currency)
+// @Tags insights
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Response{data=entity.SpendingBaselines}
+// @Failure 401 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/insights/baselines [get]
+// @Security Bearer
+func (h *InsightsHandler) Baselines(c echo.Context) error {
+	userIDStr := middleware.GetUserIDFromContext(c)
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, response.NewErrorResponse("UNAUTHORIZED", "Invalid user ID", err.Error()))
+	}
+
+	baselines, err := h.insightsService.GetBaselines(c.Request().Context(), userID)
+	if err != nil {
+		h.log.Errorw("Failed to compute spending baselines",
+			"error", err,
+			"user_id", userID,
+		)
+		return c.JSON(http.StatusInternalServerError, response.NewErrorResponse("INTERNAL_ERROR", "Failed to compute spending baselines", ""))
+	}
+
+	return c.JSON(http.StatusOK, response.NewResponse("Spending baselines retrieved successfully", baselines))
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -36,6 +37,7 @@ func NewMonobankHandler(
 	monobank.POST("/connect", handler.Connect)
 	monobank.POST("/disconnect", handler.Disconnect)
 	monobank.POST("/sync", handler.Sync)
+	monobank.POST("/reimport", handler.Reimport)
 	monobank.GET("/status", handler.Status)
 	monobank.POST("/webhook", handler.Webhook)
 
@@ -167,6 +169,79 @@ func (h *MonobankHandler) Sync(c echo.Context) error {
 	})
 }
 
+// Reimport godoc
+// @Summary Re-import Monobank statement window
+// @Description Re-fetch Monobank transactions for a date window (up to 31 days), add missing ones and apply corrections to stored ones. Cards not reached because of the Monobank rate limit are listed in skipped_cards and can be re-imported with card_id; cards with more transactions than Monobank returns before the rate limit are listed in truncated_cards and need a shorter window.
+// @Tags monobank
+// @Accept json
+// @Produce json
+// @Param from query string true "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date, inclusive (YYYY-MM-DD, default: today)"
+// @Param card_id query string false "Re-import only this card"
+// @Success 200 {object} entity.MonobankReimportResult
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
+// @Failure 429 {object} response.Response
+// @Failure 500 {object} response.Response
+// @Router /api/v1/monobank/reimport [post]
+// @Security Bearer
+func (h *MonobankHandler) Reimport(c echo.Context) error {
+	userIDStr := middleware.GetUserIDFromContext(c)
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Invalid user ID")
+	}
+
+	from := parseDate(c.QueryParam("from"))
+	if from == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid or missing from date")
+	}
+
+	to := time.Now()
+	if c.QueryParam("to") != "" {
+		toDate := parseDate(c.QueryParam("to"))
+		if toDate == nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid to date")
+		}
+		// Include the whole end day
+		to = toDate.AddDate(0, 0, 1)
+	}
+
+	var cardID *uuid.UUID
+	if c.QueryParam("card_id") != "" {
+		id, err := uuid.Parse(c.QueryParam("card_id"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid card ID")
+		}
+		cardID = &id
+	}
+
+	result, err := h.monobankService.Reimport(c.Request().Context(), userID, cardID, *from, to)
+	if err != nil {
+		switch err {
+		case errors.ErrInvalidFieldValue:
+			return echo.NewHTTPError(http.StatusBadRequest, "Date window must be positive and at most 31 days")
+		case errors.ErrMonobankIntegrationNotFound:
+			return echo.NewHTTPError(http.StatusNotFound, "Monobank integration not found")
+		case errors.ErrCardNotFound:
+			return echo.NewHTTPError(http.StatusNotFound, "Monobank card not found")
+		case errors.ErrMonobankRateLimit:
+			return echo.NewHTTPError(http.StatusTooManyRequests, "Rate limit exceeded")
+		case errors.ErrMonobankTokenInvalid:
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid Monobank token")
+		default:
+			h.log.Errorw("Failed to re-import Monobank data",
+				"error", err,
+				"user_id", userID,
+			)
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to re-import Monobank data")
+		}
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // Status godoc
 // @Summary Get Monobank integration status
 // @Description Get current status of user's Monobank integration
@@ -1,6 +1,10 @@
 package response
 
-import "time"
+import (
+	"time"
+
+	"cashone/domain/entity"
+)
 
 // Response represents a standard API response
 type Response struct {
@@ -28,10 +32,11 @@ type PaginatedResponse struct {
 
 // HealthResponse represents the health check response
 type HealthResponse struct {
-	Status    string    `json:"status"`
-	Database  string    `json:"database"`
-	Version   string    `json:"version"`
-	Timestamp time.Time `json:"timestamp"`
+	Status      string                   `json:"status"`
+	Database    string                   `json:"database"`
+	Version     string                   `json:"version"`
+	Timestamp   time.Time                `json:"timestamp"`
+	Maintenance entity.MaintenanceStatus `json:"maintenance"`
 }
 
 // NewResponse creates a new successful response
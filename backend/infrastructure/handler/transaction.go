@@ -55,6 +55,7 @@ func NewTransactionHandler(
 // @Success 200 {object} entity.Transaction
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response
 // @Failure 500 {object} response.Response
 // @Router /api/v1/transactions [post]
 // @Security Bearer
@@ -83,11 +84,16 @@ func (h *TransactionHandler) Create(c echo.Context) error {
 	}
 
 	if err := h.transactionService.Create(c.Request().Context(), transaction); err != nil {
-		h.log.Errorw("Failed to create transaction",
-			"error", err,
-			"user_id", userID,
-		)
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create transaction")
+		switch err {
+		case errors.ErrCardNotFound:
+			return echo.NewHTTPError(http.StatusNotFound, "Card not found")
+		default:
+			h.log.Errorw("Failed to create transaction",
+				"error", err,
+				"user_id", userID,
+			)
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create transaction")
+		}
 	}
 
 	return c.JSON(http.StatusOK, transaction)
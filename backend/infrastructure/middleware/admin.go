@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"cashone/domain/entity"
+	"cashone/domain/service"
+)
+
+const adminTokenHeader = "X-Admin-Token"
+
+// AdminMiddleware protects operator endpoints with a static admin token
+type AdminMiddleware struct {
+	token string
+	audit service.AuditLogger
+	log   *zap.SugaredLogger
+}
+
+// NewAdminMiddleware creates a new admin middleware. An empty token disables admin endpoints.
+func NewAdminMiddleware(token string, audit service.AuditLogger, log *zap.SugaredLogger) *AdminMiddleware {
+	return &AdminMiddleware{
+		token: token,
+		audit: audit,
+		log:   log,
+	}
+}
+
+// Authorize is a middleware that validates the admin token header
+func (m *AdminMiddleware) Authorize(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if m.token == "" {
+			return echo.NewHTTPError(http.StatusForbidden, "Admin API is disabled")
+		}
+
+		token := c.Request().Header.Get(adminTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(m.token)) != 1 {
+			m.log.Warnw("Invalid admin token",
+				"remote_ip", c.RealIP(),
+				"path", c.Path(),
+			)
+			m.audit.Record(entity.AuditEvent{
+				Type:      entity.AuditAdminAccessDenied,
+				Outcome:   entity.AuditOutcomeFailure,
+				Severity:  entity.AuditSeverityWarning,
+				IP:        c.RealIP(),
+				UserAgent: c.Request().UserAgent(),
+				Message:   c.Request().Method + " " + c.Path(),
+			})
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid admin token")
+		}
+
+		return next(c)
+	}
+}
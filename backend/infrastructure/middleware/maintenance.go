@@ -0,0 +1,223 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"cashone/domain/entity"
+	"cashone/domain/repository"
+	"cashone/pkg/config"
+)
+
+// maintenanceExemptPaths stay writable in maintenance mode so users can start and end sessions,
+// operators can switch the mode off again, and Monobank webhooks are not lost: Monobank retries
+// a failed delivery only briefly and then stops sending events
+var maintenanceExemptPaths = map[string]bool{
+	"/api/v1/auth/login":        true,
+	"/api/v1/auth/refresh":      true,
+	"/api/v1/auth/logout":       true,
+	"/api/v1/monobank/webhook":  true,
+	"/api/v1/admin/maintenance": true,
+}
+
+// defaultMaintenancePollInterval is used when the configured poll interval is not positive
+const defaultMaintenancePollInterval = 15 * time.Second
+
+// MaintenanceMode holds the read-only switch of the API and rejects mutating requests while it is on.
+// The switch is persisted in the database, so it applies to all server instances and survives restarts.
+type MaintenanceMode struct {
+	repo         repository.MaintenanceRepository
+	pollInterval time.Duration
+	log          *zap.SugaredLogger
+
+	mu         sync.RWMutex
+	enabled    bool
+	reason     string
+	since      time.Time
+	retryAfter time.Duration
+}
+
+// NewMaintenanceMode creates a new maintenance switch with the persisted state.
+// Maintenance enabled in configuration is switched on at startup.
+func NewMaintenanceMode(cfg *config.MaintenanceConfig, repo repository.MaintenanceRepository, log *zap.SugaredLogger) *MaintenanceMode {
+	m := &MaintenanceMode{
+		repo:         repo,
+		pollInterval: cfg.PollInterval,
+		log:          log,
+		retryAfter:   cfg.RetryAfter,
+	}
+
+	ctx := context.Background()
+	if err := m.refresh(ctx); err != nil {
+		log.Warnw("Failed to load maintenance mode state", "error", err)
+	}
+
+	if cfg.Enabled && !m.Enabled() {
+		if err := m.Enable(ctx, cfg.Reason, cfg.RetryAfter); err != nil {
+			log.Warnw("Failed to persist maintenance mode, it is enabled on this instance only", "error", err)
+			since := time.Now().UTC()
+			m.apply(&entity.MaintenanceState{
+				Enabled:    true,
+				Reason:     cfg.Reason,
+				Since:      &since,
+				RetryAfter: int(cfg.RetryAfter.Seconds()),
+			})
+		}
+	}
+	return m
+}
+
+// Enable switches all instances to read-only mode
+func (m *MaintenanceMode) Enable(ctx context.Context, reason string, retryAfter time.Duration) error {
+	status := m.Status()
+	since := time.Now().UTC()
+	if status.Enabled {
+		since = *status.Since
+	}
+	if retryAfter <= 0 {
+		m.mu.RLock()
+		retryAfter = m.retryAfter
+		m.mu.RUnlock()
+	}
+
+	state := &entity.MaintenanceState{
+		Enabled:    true,
+		Reason:     reason,
+		Since:      &since,
+		RetryAfter: int(retryAfter.Seconds()),
+	}
+	if err := m.repo.Save(ctx, state); err != nil {
+		return err
+	}
+	m.apply(state)
+	return nil
+}
+
+// Disable switches all instances back to normal operation
+func (m *MaintenanceMode) Disable(ctx context.Context) error {
+	state := &entity.MaintenanceState{Enabled: false}
+	if err := m.repo.Save(ctx, state); err != nil {
+		return err
+	}
+	m.apply(state)
+	return nil
+}
+
+// Enabled reports whether maintenance mode is on
+func (m *MaintenanceMode) Enabled() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.enabled
+}
+
+// Run reloads the persisted state every poll interval until the context is cancelled,
+// so changes made through another instance take effect here.
+// The last known state is kept while the database is unavailable.
+func (m *MaintenanceMode) Run(ctx context.Context) {
+	interval := m.pollInterval
+	if interval <= 0 {
+		interval = defaultMaintenancePollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	failing := false
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.refresh(ctx); err != nil {
+				if !failing {
+					m.log.Warnw("Failed to reload maintenance mode state, keeping the last known state", "error", err)
+				}
+				failing = true
+				continue
+			}
+			if failing {
+				m.log.Infow("Maintenance mode state reloaded again")
+			}
+			failing = false
+		}
+	}
+}
+
+// refresh loads the persisted state; a missing row keeps the current state
+func (m *MaintenanceMode) refresh(ctx context.Context) error {
+	state, err := m.repo.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if state != nil {
+		m.apply(state)
+	}
+	return nil
+}
+
+// apply updates the in-memory state and logs transitions
+func (m *MaintenanceMode) apply(state *entity.MaintenanceState) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch {
+	case state.Enabled && !m.enabled:
+		m.log.Warnw("Maintenance mode enabled",
+			"reason", state.Reason,
+			"retry_after", time.Duration(state.RetryAfter)*time.Second,
+		)
+	case !state.Enabled && m.enabled:
+		m.log.Infow("Maintenance mode disabled", "duration", time.Since(m.since))
+	}
+
+	m.enabled = state.Enabled
+	m.reason = state.Reason
+	m.since = time.Time{}
+	if state.Since != nil {
+		m.since = state.Since.UTC()
+	}
+	if state.RetryAfter > 0 {
+		m.retryAfter = time.Duration(state.RetryAfter) * time.Second
+	}
+}
+
+// Status returns the current maintenance state
+func (m *MaintenanceMode) Status() entity.MaintenanceStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	status := entity.MaintenanceStatus{Enabled: m.enabled}
+	if m.enabled {
+		since := m.since
+		status.Reason = m.reason
+		status.Since = &since
+		status.RetryAfter = int(m.retryAfter.Seconds())
+	}
+	return status
+}
+
+// Guard is a middleware that rejects mutating requests with 503 while maintenance mode is on
+func (m *MaintenanceMode) Guard(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		switch c.Request().Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return next(c)
+		}
+
+		status := m.Status()
+		if !status.Enabled || maintenanceExemptPaths[c.Path()] {
+			return next(c)
+		}
+
+		if status.RetryAfter > 0 {
+			c.Response().Header().Set("Retry-After", strconv.Itoa(status.RetryAfter))
+		}
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "Service is in maintenance mode, changes are temporarily disabled")
+	}
+}
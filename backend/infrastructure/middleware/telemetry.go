@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"cashone/infrastructure/telemetry"
+)
+
+const apiPrefix = "/api/v1/"
+
+// TelemetryMiddleware counts anonymous feature usage and error categories per API route
+type TelemetryMiddleware struct {
+	collector *telemetry.Collector
+}
+
+// NewTelemetryMiddleware creates a new telemetry middleware
+func NewTelemetryMiddleware(collector *telemetry.Collector) *TelemetryMiddleware {
+	return &TelemetryMiddleware{collector: collector}
+}
+
+// Track records the route template (never the request URI or body) of every API request
+func (m *TelemetryMiddleware) Track(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !m.collector.Enabled() {
+			return next(c)
+		}
+
+		err := next(c)
+
+		feature := routeFeature(c.Path())
+		if feature == "" {
+			return err
+		}
+		m.collector.RecordFeature(feature)
+
+		status := c.Response().Status
+		if he, ok := err.(*echo.HTTPError); ok {
+			status = he.Code
+		}
+		if status >= 400 {
+			m.collector.RecordError(fmt.Sprintf("%s:%d", strings.SplitN(feature, "/", 2)[0], status))
+		}
+
+		return err
+	}
+}
+
+// routeFeature turns a route template such as /api/v1/cards/:id/default-category
+// into a feature name such as cards/default-category, dropping path parameters
+func routeFeature(path string) string {
+	if !strings.HasPrefix(path, apiPrefix) {
+		return ""
+	}
+
+	var parts []string
+	for _, segment := range strings.Split(strings.TrimPrefix(path, apiPrefix), "/") {
+		if segment == "" || strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			continue
+		}
+		parts = append(parts, segment)
+	}
+	return strings.Join(parts, "/")
+}
@@ -103,6 +103,27 @@ func (r *cardRepository) Update(ctx context.Context, card *entity.Card) error {
 	return nil
 }
 
+func (r *cardRepository) UpdateDefaultCategory(ctx context.Context, id uuid.UUID, categoryID *uuid.UUID) error {
+	result := r.db.WithContext(ctx).Model(&entity.Card{}).
+		Where("id = ?", id).
+		Update("default_category_id", categoryID)
+
+	if result.Error != nil {
+		r.log.Errorw("Failed to update card default category",
+			"error", result.Error,
+			"id", id,
+			"category_id", categoryID,
+		)
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
 func (r *cardRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	// Start a transaction to handle cascading deletes
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
@@ -15,6 +15,7 @@ type Factory interface {
 	NewCategoryRepository() repository.CategoryRepository
 	NewMonobankIntegrationRepository() repository.MonobankIntegrationRepository
 	NewRefreshTokenRepository() repository.RefreshTokenRepository
+	NewMaintenanceRepository() repository.MaintenanceRepository
 }
 
 type factory struct {
@@ -59,3 +60,8 @@ func (f *factory) NewMonobankIntegrationRepository() repository.MonobankIntegrat
 func (f *factory) NewRefreshTokenRepository() repository.RefreshTokenRepository {
 	return NewRefreshTokenRepository(f.db, f.log)
 }
+
+// NewMaintenanceRepository creates a new maintenance state repository instance
+func (f *factory) NewMaintenanceRepository() repository.MaintenanceRepository {
+	return NewMaintenanceRepository(f.db, f.log)
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"cashone/domain/entity"
+	"cashone/domain/repository"
+)
+
+// maintenanceStateID is the primary key of the single maintenance state row
+const maintenanceStateID = 1
+
+type maintenanceRepository struct {
+	db  *gorm.DB
+	log *zap.SugaredLogger
+}
+
+// NewMaintenanceRepository creates a new maintenance state repository instance
+func NewMaintenanceRepository(db *gorm.DB, log *zap.SugaredLogger) repository.MaintenanceRepository {
+	return &maintenanceRepository{
+		db:  db,
+		log: log,
+	}
+}
+
+func (r *maintenanceRepository) Get(ctx context.Context) (*entity.MaintenanceState, error) {
+	var state entity.MaintenanceState
+	if err := r.db.WithContext(ctx).First(&state, maintenanceStateID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (r *maintenanceRepository) Save(ctx context.Context, state *entity.MaintenanceState) error {
+	state.ID = maintenanceStateID
+	if err := r.db.WithContext(ctx).Save(state).Error; err != nil {
+		r.log.Errorw("Failed to save maintenance state",
+			"error", err,
+			"enabled", state.Enabled,
+		)
+		return err
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -81,6 +82,18 @@ func (r *transactionRepository) GetByMonobankID(ctx context.Context, monobankID
 	return &transaction, nil
 }
 
+func (r *transactionRepository) GetByUserIDInPeriod(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]entity.Transaction, error) {
+	var transactions []entity.Transaction
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND transaction_date >= ? AND transaction_date < ?", userID, from, to).
+		Order("transaction_date ASC").
+		Find(&transactions).Error
+	if err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
 func (r *transactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
 	return r.db.WithContext(ctx).Save(transaction).Error
 }
@@ -8,11 +8,11 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
-	"golang.org/x/crypto/bcrypt"
 
 	"cashone/domain/entity"
 	"cashone/domain/errors"
 	"cashone/domain/repository"
+	"cashone/domain/service"
 	"cashone/pkg/config"
 )
 
@@ -20,6 +20,9 @@ import (
 type AuthService struct {
 	userRepo         repository.UserRepository
 	refreshTokenRepo repository.RefreshTokenRepository
+	hasher           service.PasswordHasher
+	audit            service.AuditLogger
+	maintenance      service.MaintenanceSwitch
 	config           *config.Config
 	log              *zap.SugaredLogger
 }
@@ -28,12 +31,18 @@ type AuthService struct {
 func NewAuthService(
 	userRepo repository.UserRepository,
 	refreshTokenRepo repository.RefreshTokenRepository,
+	hasher service.PasswordHasher,
+	audit service.AuditLogger,
+	maintenance service.MaintenanceSwitch,
 	config *config.Config,
 	log *zap.SugaredLogger,
 ) *AuthService {
 	return &AuthService{
 		userRepo:         userRepo,
 		refreshTokenRepo: refreshTokenRepo,
+		hasher:           hasher,
+		audit:            audit,
+		maintenance:      maintenance,
 		config:           config,
 		log:              log,
 	}
@@ -67,6 +76,14 @@ func (s *AuthService) Register(ctx context.Context, req *entity.RegisterRequest)
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
+	s.audit.Record(entity.AuditEvent{
+		Type:     entity.AuditUserRegistered,
+		Outcome:  entity.AuditOutcomeSuccess,
+		Severity: entity.AuditSeverityInformation,
+		UserID:   &user.ID,
+		Email:    user.Email,
+	})
+
 	// Generate tokens
 	authToken, err := s.GenerateTokens(ctx, user, "", "")
 	if err != nil {
@@ -87,42 +104,65 @@ func (s *AuthService) Login(ctx context.Context, req *entity.LoginRequest) (*ent
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 	if user == nil {
+		s.recordLoginFailure(req, nil, "unknown email")
 		return nil, errors.ErrInvalidCredentials
 	}
 
 	// Verify password
 	if err := s.VerifyPassword(req.Password, user.PasswordHash); err != nil {
+		s.recordLoginFailure(req, &user.ID, "invalid password")
 		return nil, errors.ErrInvalidCredentials
 	}
 
+	// Upgrade the stored hash if the hashing algorithm or cost changed,
+	// unless maintenance mode protects the database from writes
+	if s.hasher.NeedsRehash(user.PasswordHash) && !s.maintenance.Enabled() {
+		s.rehashPassword(ctx, user, req.Password)
+	}
+
 	// Generate tokens
 	authToken, err := s.GenerateTokens(ctx, user, req.UserAgent, req.IP)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate tokens: %w", err)
 	}
 
+	s.audit.Record(entity.AuditEvent{
+		Type:      entity.AuditLoginSucceeded,
+		Outcome:   entity.AuditOutcomeSuccess,
+		Severity:  entity.AuditSeverityInformation,
+		UserID:    &user.ID,
+		Email:     user.Email,
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+	})
+
 	return &entity.LoginResponse{
 		User:      user,
 		AuthToken: authToken,
 	}, nil
 }
 
-// RefreshToken generates new authentication tokens using a valid refresh token
-func (s *AuthService) RefreshToken(ctx context.Context, token string) (*entity.AuthToken, error) {
+// RefreshToken generates new authentication tokens using a valid refresh token.
+// The user agent and IP are those of the caller and are stored with the new refresh token.
+func (s *AuthService) RefreshToken(ctx context.Context, token, userAgent, ip string) (*entity.AuthToken, error) {
 	// Get refresh token from database
 	refreshToken, err := s.refreshTokenRepo.GetByToken(ctx, token)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get refresh token: %w", err)
 	}
 	if refreshToken == nil {
+		s.recordRefreshFailure(nil, userAgent, ip, entity.AuditSeverityNotice, "unknown refresh token")
 		return nil, errors.ErrInvalidToken
 	}
 
 	// Check if token is expired or revoked
 	if refreshToken.ExpiresAt.Before(time.Now()) {
+		s.recordRefreshFailure(&refreshToken.UserID, userAgent, ip, entity.AuditSeverityInformation, "expired refresh token")
 		return nil, errors.ErrTokenExpired
 	}
 	if refreshToken.RevokedAt != nil {
+		// Reuse of a revoked token may indicate a stolen token
+		s.recordRefreshFailure(&refreshToken.UserID, userAgent, ip, entity.AuditSeverityWarning, "revoked refresh token reused")
 		return nil, errors.ErrInvalidToken
 	}
 
@@ -136,7 +176,7 @@ func (s *AuthService) RefreshToken(ctx context.Context, token string) (*entity.A
 	}
 
 	// Generate new tokens
-	authToken, err := s.GenerateTokens(ctx, user, refreshToken.UserAgent, refreshToken.IP)
+	authToken, err := s.GenerateTokens(ctx, user, userAgent, ip)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate tokens: %w", err)
 	}
@@ -150,11 +190,20 @@ func (s *AuthService) RefreshToken(ctx context.Context, token string) (*entity.A
 }
 
 // Logout revokes the specified refresh token
-func (s *AuthService) Logout(ctx context.Context, userID uuid.UUID, token string) error {
+func (s *AuthService) Logout(ctx context.Context, userID uuid.UUID, token, userAgent, ip string) error {
 	err := s.refreshTokenRepo.Revoke(ctx, token)
 	if err != nil {
 		return errors.ErrDatabaseOperation
 	}
+
+	s.audit.Record(entity.AuditEvent{
+		Type:      entity.AuditLogout,
+		Outcome:   entity.AuditOutcomeSuccess,
+		Severity:  entity.AuditSeverityInformation,
+		UserID:    &userID,
+		IP:        ip,
+		UserAgent: userAgent,
+	})
 	return nil
 }
 
@@ -178,18 +227,70 @@ func (s *AuthService) ValidateToken(ctx context.Context, tokenString string) (*e
 	return nil, errors.ErrInvalidToken
 }
 
-// HashPassword generates a bcrypt hash of the provided password
+// HashPassword hashes the provided password with the configured algorithm
 func (s *AuthService) HashPassword(password string) (string, error) {
-	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
-		return "", fmt.Errorf("failed to hash password: %w", err)
-	}
-	return string(hashedBytes), nil
+	return s.hasher.Hash(password)
 }
 
 // VerifyPassword checks if the provided password matches the hash
 func (s *AuthService) VerifyPassword(password, hash string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	return s.hasher.Verify(password, hash)
+}
+
+// rehashPassword stores a new hash of the password; failures are logged and do not affect the login
+func (s *AuthService) rehashPassword(ctx context.Context, user *entity.User, password string) {
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		s.log.Errorw("Failed to rehash password", "error", err, "user_id", user.ID)
+		return
+	}
+
+	previousHash := user.PasswordHash
+	user.PasswordHash = hash
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		user.PasswordHash = previousHash
+		s.log.Errorw("Failed to store rehashed password", "error", err, "user_id", user.ID)
+		return
+	}
+
+	s.log.Infow("Password hash upgraded",
+		"user_id", user.ID,
+		"algorithm", s.config.Security.Password.Algorithm,
+	)
+	s.audit.Record(entity.AuditEvent{
+		Type:     entity.AuditPasswordRehashed,
+		Outcome:  entity.AuditOutcomeSuccess,
+		Severity: entity.AuditSeverityInformation,
+		UserID:   &user.ID,
+		Message:  "algorithm=" + s.config.Security.Password.Algorithm,
+	})
+}
+
+// recordLoginFailure records a failed login attempt; the user ID is known only if the email exists
+func (s *AuthService) recordLoginFailure(req *entity.LoginRequest, userID *uuid.UUID, reason string) {
+	s.audit.Record(entity.AuditEvent{
+		Type:      entity.AuditLoginFailed,
+		Outcome:   entity.AuditOutcomeFailure,
+		Severity:  entity.AuditSeverityNotice,
+		UserID:    userID,
+		Email:     req.Email,
+		IP:        req.IP,
+		UserAgent: req.UserAgent,
+		Message:   reason,
+	})
+}
+
+// recordRefreshFailure records a rejected refresh token along with the client that presented it
+func (s *AuthService) recordRefreshFailure(userID *uuid.UUID, userAgent, ip string, severity int, reason string) {
+	s.audit.Record(entity.AuditEvent{
+		Type:      entity.AuditTokenRefreshFailed,
+		Outcome:   entity.AuditOutcomeFailure,
+		Severity:  severity,
+		UserID:    userID,
+		IP:        ip,
+		UserAgent: userAgent,
+		Message:   reason,
+	})
 }
 
 // GenerateTokens generates new access and refresh tokens for a user
@@ -241,7 +342,17 @@ func (s *AuthService) GenerateTokens(ctx context.Context, user *entity.User, use
 
 // RevokeAllUserTokens revokes all refresh tokens for a user
 func (s *AuthService) RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error {
-	return s.refreshTokenRepo.RevokeAllUserTokens(ctx, userID)
+	if err := s.refreshTokenRepo.RevokeAllUserTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	s.audit.Record(entity.AuditEvent{
+		Type:     entity.AuditTokensRevoked,
+		Outcome:  entity.AuditOutcomeSuccess,
+		Severity: entity.AuditSeverityNotice,
+		UserID:   &userID,
+	})
+	return nil
 }
 
 // GetActiveTokens returns all active refresh tokens for a user
@@ -14,21 +14,24 @@ import (
 )
 
 type cardService struct {
-	cardRepo repository.CardRepository
-	userRepo repository.UserRepository
-	log      *zap.SugaredLogger
+	cardRepo     repository.CardRepository
+	categoryRepo repository.CategoryRepository
+	userRepo     repository.UserRepository
+	log          *zap.SugaredLogger
 }
 
 // NewCardService creates a new card service
 func NewCardService(
 	cardRepo repository.CardRepository,
+	categoryRepo repository.CategoryRepository,
 	userRepo repository.UserRepository,
 	log *zap.SugaredLogger,
 ) service.CardService {
 	return &cardService{
-		cardRepo: cardRepo,
-		userRepo: userRepo,
-		log:      log,
+		cardRepo:     cardRepo,
+		categoryRepo: categoryRepo,
+		userRepo:     userRepo,
+		log:          log,
 	}
 }
 
@@ -161,6 +164,42 @@ func (s *cardService) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// SetDefaultCategory sets the category applied to uncategorized transactions of the card.
+// A nil categoryID clears the default.
+func (s *cardService) SetDefaultCategory(ctx context.Context, userID, cardID uuid.UUID, categoryID *uuid.UUID) (*entity.Card, error) {
+	// Check if card exists and belongs to the user
+	card, err := s.cardRepo.GetByID(ctx, cardID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseOperation, err)
+	}
+	if card == nil || card.UserID != userID {
+		return nil, errors.ErrCardNotFound
+	}
+
+	// Check if category exists and belongs to the user
+	if categoryID != nil {
+		category, err := s.categoryRepo.GetByID(ctx, *categoryID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseOperation, err)
+		}
+		if category == nil || category.UserID != userID {
+			return nil, errors.ErrCategoryNotFound
+		}
+	}
+
+	if err := s.cardRepo.UpdateDefaultCategory(ctx, cardID, categoryID); err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseOperation, err)
+	}
+	card.DefaultCategoryID = categoryID
+
+	s.log.Infow("Card default category updated",
+		"id", card.ID,
+		"user_id", card.UserID,
+		"category_id", categoryID,
+	)
+	return card, nil
+}
+
 func (s *cardService) validateCard(card *entity.Card) error {
 	if card == nil {
 		return errors.ErrInvalidCardData
@@ -11,14 +11,27 @@ import (
 // serviceFactory implements the service.Factory interface
 type serviceFactory struct {
 	repoFactory repository.Factory
+	hasher      service.PasswordHasher
+	audit       service.AuditLogger
+	maintenance service.MaintenanceSwitch
 	config      *config.Config
 	log         *zap.SugaredLogger
 }
 
 // NewFactory creates a new service factory instance
-func NewFactory(repoFactory repository.Factory, config *config.Config, log *zap.SugaredLogger) service.Factory {
+func NewFactory(
+	repoFactory repository.Factory,
+	hasher service.PasswordHasher,
+	audit service.AuditLogger,
+	maintenance service.MaintenanceSwitch,
+	config *config.Config,
+	log *zap.SugaredLogger,
+) service.Factory {
 	return &serviceFactory{
 		repoFactory: repoFactory,
+		hasher:      hasher,
+		audit:       audit,
+		maintenance: maintenance,
 		config:      config,
 		log:         log,
 	}
@@ -26,17 +39,22 @@ func NewFactory(repoFactory repository.Factory, config *config.Config, log *zap.
 
 // NewUserService creates a new user service instance
 func (f *serviceFactory) NewUserService() service.UserService {
-	return NewUserService(f.repoFactory.NewUserRepository(), f.log)
+	return NewUserService(f.repoFactory.NewUserRepository(), f.hasher, f.log)
 }
 
 // NewCardService creates a new card service instance
 func (f *serviceFactory) NewCardService() service.CardService {
-	return NewCardService(f.repoFactory.NewCardRepository(), f.repoFactory.NewUserRepository(), f.log)
+	return NewCardService(
+		f.repoFactory.NewCardRepository(),
+		f.repoFactory.NewCategoryRepository(),
+		f.repoFactory.NewUserRepository(),
+		f.log,
+	)
 }
 
 // NewTransactionService creates a new transaction service instance
 func (f *serviceFactory) NewTransactionService() service.TransactionService {
-	return NewTransactionService(f.repoFactory.NewTransactionRepository(), f.log)
+	return NewTransactionService(f.repoFactory.NewTransactionRepository(), f.repoFactory.NewCardRepository(), f.log)
 }
 
 // NewCategoryService creates a new category service instance
@@ -60,7 +78,15 @@ func (f *serviceFactory) NewAuthService() service.AuthService {
 	return NewAuthService(
 		f.repoFactory.NewUserRepository(),
 		f.repoFactory.NewRefreshTokenRepository(),
+		f.hasher,
+		f.audit,
+		f.maintenance,
 		f.config,
 		f.log,
 	)
 }
+
+// NewInsightsService creates a new insights service instance
+func (f *serviceFactory) NewInsightsService() service.InsightsService {
+	return NewInsightsService(f.repoFactory.NewTransactionRepository(), f.repoFactory.NewCardRepository(), f.log)
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"cashone/domain/entity"
+	"cashone/domain/errors"
+	"cashone/domain/repository"
+	"cashone/domain/service"
+)
+
+// baselineMonths is the number of full months used to compute spending baselines
+const baselineMonths = 6
+
+type insightsService struct {
+	transactionRepo repository.TransactionRepository
+	cardRepo        repository.CardRepository
+	log             *zap.SugaredLogger
+}
+
+// NewInsightsService creates a new insights service
+func NewInsightsService(
+	transactionRepo repository.TransactionRepository,
+	cardRepo repository.CardRepository,
+	log *zap.SugaredLogger,
+) service.InsightsService {
+	return &insightsService{
+		transactionRepo: transactionRepo,
+		cardRepo:        cardRepo,
+		log:             log,
+	}
+}
+
+// GetBaselines computes rolling medians of the user's expenses over the last full months:
+// monthly spend per category and transaction size per MCC and merchant, each per card currency.
+// Transaction amounts are always in the currency of their card, while the transaction's own
+// currency code is the currency of the purchase, so grouping uses the card's currency.
+func (s *insightsService) GetBaselines(ctx context.Context, userID uuid.UUID) (*entity.SpendingBaselines, error) {
+	now := time.Now().UTC()
+	to := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, -baselineMonths, 0)
+
+	transactions, err := s.transactionRepo.GetByUserIDInPeriod(ctx, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseOperation, err)
+	}
+
+	cards, err := s.cardRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseOperation, err)
+	}
+	cardCurrencies := make(map[uuid.UUID]int, len(cards))
+	for _, card := range cards {
+		cardCurrencies[card.ID] = card.CurrencyCode
+	}
+
+	type categoryKey struct {
+		categoryID uuid.UUID
+		currency   int
+	}
+	type categoryTotals struct {
+		categoryID *uuid.UUID
+		currency   int
+		monthly    [baselineMonths]int64
+		count      int
+	}
+	type mccKey struct {
+		mcc      int
+		currency int
+	}
+	type merchantKey struct {
+		name     string
+		mcc      int
+		currency int
+	}
+
+	// Uncategorized expenses are keyed by uuid.Nil
+	categories := make(map[categoryKey]*categoryTotals)
+	mccAmounts := make(map[mccKey][]int64)
+	merchantAmounts := make(map[merchantKey][]int64)
+
+	for _, tx := range transactions {
+		if tx.Type != "expense" {
+			continue
+		}
+		currency, ok := cardCurrencies[tx.CardID]
+		if !ok {
+			continue
+		}
+
+		key := categoryKey{currency: currency}
+		if tx.CategoryID != nil {
+			key.categoryID = *tx.CategoryID
+		}
+		totals := categories[key]
+		if totals == nil {
+			totals = &categoryTotals{categoryID: tx.CategoryID, currency: currency}
+			categories[key] = totals
+		}
+		month := monthsBetween(from, tx.TransactionDate.UTC())
+		totals.monthly[month] += tx.Amount
+		totals.count++
+
+		if tx.MCC != 0 {
+			key := mccKey{mcc: tx.MCC, currency: currency}
+			mccAmounts[key] = append(mccAmounts[key], tx.Amount)
+		}
+		if merchant := strings.TrimSpace(tx.Description); merchant != "" {
+			key := merchantKey{name: merchant, mcc: tx.MCC, currency: currency}
+			merchantAmounts[key] = append(merchantAmounts[key], tx.Amount)
+		}
+	}
+
+	baselines := &entity.SpendingBaselines{
+		From:       from,
+		To:         to,
+		Months:     baselineMonths,
+		Categories: []entity.CategoryBaseline{},
+		MCCs:       []entity.MCCBaseline{},
+		Merchants:  []entity.MerchantBaseline{},
+	}
+
+	for _, totals := range categories {
+		baselines.Categories = append(baselines.Categories, entity.CategoryBaseline{
+			CategoryID:         totals.categoryID,
+			CurrencyCode:       totals.currency,
+			MedianMonthlySpend: median(totals.monthly[:]),
+			TransactionCount:   totals.count,
+		})
+	}
+	sort.Slice(baselines.Categories, func(i, j int) bool {
+		a, b := baselines.Categories[i], baselines.Categories[j]
+		if a.CurrencyCode != b.CurrencyCode {
+			return a.CurrencyCode < b.CurrencyCode
+		}
+		if a.MedianMonthlySpend != b.MedianMonthlySpend {
+			return a.MedianMonthlySpend > b.MedianMonthlySpend
+		}
+		// Uncategorized sorts last among equal medians
+		if a.CategoryID == nil || b.CategoryID == nil {
+			return b.CategoryID == nil && a.CategoryID != nil
+		}
+		return a.CategoryID.String() < b.CategoryID.String()
+	})
+
+	for key, amounts := range mccAmounts {
+		baselines.MCCs = append(baselines.MCCs, entity.MCCBaseline{
+			MCC:                     key.mcc,
+			CurrencyCode:            key.currency,
+			MedianTransactionAmount: median(amounts),
+			TransactionCount:        len(amounts),
+		})
+	}
+	sort.Slice(baselines.MCCs, func(i, j int) bool {
+		a, b := baselines.MCCs[i], baselines.MCCs[j]
+		if a.MCC != b.MCC {
+			return a.MCC < b.MCC
+		}
+		return a.CurrencyCode < b.CurrencyCode
+	})
+
+	for key, amounts := range merchantAmounts {
+		baselines.Merchants = append(baselines.Merchants, entity.MerchantBaseline{
+			Merchant:                key.name,
+			MCC:                     key.mcc,
+			CurrencyCode:            key.currency,
+			MedianTransactionAmount: median(amounts),
+			TransactionCount:        len(amounts),
+		})
+	}
+	sort.Slice(baselines.Merchants, func(i, j int) bool {
+		a, b := baselines.Merchants[i], baselines.Merchants[j]
+		if a.TransactionCount != b.TransactionCount {
+			return a.TransactionCount > b.TransactionCount
+		}
+		if a.Merchant != b.Merchant {
+			return a.Merchant < b.Merchant
+		}
+		if a.MCC != b.MCC {
+			return a.MCC < b.MCC
+		}
+		return a.CurrencyCode < b.CurrencyCode
+	})
+
+	return baselines, nil
+}
+
+// monthsBetween returns the number of calendar months from the start month to t
+func monthsBetween(start, t time.Time) int {
+	return (t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month())
+}
+
+// median returns the median of the values without modifying the input
+func median(values []int64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := make([]int64, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
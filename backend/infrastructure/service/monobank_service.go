@@ -17,6 +17,13 @@ import (
 	"cashone/domain/service"
 )
 
+// maxMonobankStatementWindow is the longest period the Monobank statement API returns in one request
+const maxMonobankStatementWindow = 31*24*time.Hour + time.Hour
+
+// monobankStatementLimit is the most items the Monobank statement API returns in one request;
+// a full page means older transactions in the window have to be fetched with another request
+const monobankStatementLimit = 500
+
 // MonobankService implements the service.MonobankService interface
 type MonobankService struct {
 	monoRepo   repository.MonobankIntegrationRepository
@@ -219,6 +226,121 @@ func (s *MonobankService) SyncUserData(ctx context.Context, userID uuid.UUID) er
 	return nil
 }
 
+// Reimport implements service.MonobankService. It re-fetches the statement window for every
+// Monobank card, or only the given one, creates missing transactions and applies corrections
+// to stored ones. Monobank allows one statement request per minute, so once the rate limit is
+// hit the remaining cards are reported as skipped together with the changes already made.
+func (s *MonobankService) Reimport(ctx context.Context, userID uuid.UUID, cardID *uuid.UUID, from, to time.Time) (*entity.MonobankReimportResult, error) {
+	if !from.Before(to) || to.Sub(from) > maxMonobankStatementWindow {
+		return nil, errors.ErrInvalidFieldValue
+	}
+
+	// Get integration
+	integration, err := s.monoRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseOperation, err)
+	}
+	if integration == nil {
+		return nil, errors.ErrMonobankIntegrationNotFound
+	}
+
+	// Get cards
+	cards, err := s.cardRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrDatabaseOperation, err)
+	}
+
+	var monoCards []*entity.Card
+	for i := range cards {
+		card := &cards[i]
+		if card.IsManual || card.MonobankAccountID == "" {
+			continue
+		}
+		if cardID != nil && card.ID != *cardID {
+			continue
+		}
+		monoCards = append(monoCards, card)
+	}
+	if cardID != nil && len(monoCards) == 0 {
+		return nil, errors.ErrCardNotFound
+	}
+
+	result := &entity.MonobankReimportResult{
+		From:               from,
+		To:                 to,
+		Added:              []entity.Transaction{},
+		Updated:            []entity.TransactionDiff{},
+		FailedCards:        []uuid.UUID{},
+		SkippedCards:       []uuid.UUID{},
+		TruncatedCards:     []uuid.UUID{},
+		FailedTransactions: []string{},
+	}
+
+	processed := false
+	for i, card := range monoCards {
+		fetched, err := s.reimportCard(ctx, card, integration.Token, from, to, result)
+		if fetched {
+			processed = true
+		}
+		if err == nil {
+			continue
+		}
+
+		if fetched {
+			// Only the newest part of the window was reconciled
+			s.log.Warnw("Monobank card statement re-imported partially",
+				"error", err,
+				"card_id", card.ID,
+			)
+			result.TruncatedCards = append(result.TruncatedCards, card.ID)
+		}
+
+		if err == errors.ErrMonobankRateLimit || err == errors.ErrMonobankTokenInvalid {
+			// Nothing was changed yet, so the error can be returned as is
+			if !processed {
+				return nil, err
+			}
+			remaining := monoCards[i:]
+			if fetched {
+				remaining = monoCards[i+1:]
+			}
+			s.log.Warnw("Monobank re-import stopped early, remaining cards skipped",
+				"error", err,
+				"user_id", userID,
+				"skipped", len(remaining),
+			)
+			for _, skipped := range remaining {
+				result.SkippedCards = append(result.SkippedCards, skipped.ID)
+			}
+			break
+		}
+
+		if !fetched {
+			s.log.Errorw("Failed to fetch card statement for re-import",
+				"error", err,
+				"card_id", card.ID,
+				"account_id", card.MonobankAccountID,
+			)
+			result.FailedCards = append(result.FailedCards, card.ID)
+		}
+	}
+
+	s.log.Infow("Monobank statement re-imported",
+		"user_id", userID,
+		"from", from,
+		"to", to,
+		"fetched", result.Fetched,
+		"added", len(result.Added),
+		"updated", len(result.Updated),
+		"failed_cards", len(result.FailedCards),
+		"skipped_cards", len(result.SkippedCards),
+		"truncated_cards", len(result.TruncatedCards),
+		"failed_transactions", len(result.FailedTransactions),
+	)
+
+	return result, nil
+}
+
 // HandleWebhook implements service.MonobankService
 func (s *MonobankService) HandleWebhook(ctx context.Context, data []byte) error {
 	var webhook struct {
@@ -308,55 +430,66 @@ func (s *MonobankService) getMonobankClientInfo(token string) (*monobankClientIn
 	return &clientInfo, nil
 }
 
-func (s *MonobankService) syncCardTransactions(ctx context.Context, card *entity.Card, token string) error {
-	// Get last transaction time
-	lastTx, err := s.txRepo.GetByCardID(ctx, card.ID, 1, 0)
-	if err != nil {
-		return fmt.Errorf("%w: failed to get last transaction", errors.ErrDatabaseOperation)
-	}
-
-	var from int64
-	if len(lastTx) > 0 {
-		from = lastTx[0].TransactionDate.Unix()
-	} else {
-		// If no transactions, get last month
-		from = time.Now().AddDate(0, -1, 0).Unix()
+// getMonobankStatement fetches account statement items between from and to (unix seconds).
+// A zero to fetches up to the current time.
+func (s *MonobankService) getMonobankStatement(accountID, token string, from, to int64) ([]monobankTransaction, error) {
+	url := fmt.Sprintf("%s/personal/statement/%s/%d", viper.GetString("monobank.api_url"), accountID, from)
+	if to > 0 {
+		url = fmt.Sprintf("%s/%d", url, to)
 	}
 
-	// Get transactions from Monobank API
-	req, err := http.NewRequest("GET", fmt.Sprintf(
-		"%s/personal/statement/%s/%d",
-		viper.GetString("monobank.api_url"),
-		card.MonobankAccountID,
-		from,
-	), nil)
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		return fmt.Errorf("%w: failed to create request", errors.ErrInternal)
+		return nil, fmt.Errorf("%w: failed to create request", errors.ErrInternal)
 	}
 
 	req.Header.Set("X-Token", token)
 
 	resp, err := s.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("%w: failed to make request", errors.ErrMonobankAPIError)
+		return nil, fmt.Errorf("%w: failed to make request", errors.ErrMonobankAPIError)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusTooManyRequests {
-		return errors.ErrMonobankRateLimit
+		return nil, errors.ErrMonobankRateLimit
 	}
 
 	if resp.StatusCode == http.StatusUnauthorized {
-		return errors.ErrMonobankTokenInvalid
+		return nil, errors.ErrMonobankTokenInvalid
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("%w: status %d", errors.ErrMonobankAPIError, resp.StatusCode)
+		return nil, fmt.Errorf("%w: status %d", errors.ErrMonobankAPIError, resp.StatusCode)
 	}
 
 	var transactions []monobankTransaction
 	if err := json.NewDecoder(resp.Body).Decode(&transactions); err != nil {
-		return fmt.Errorf("%w: failed to decode response", errors.ErrMonobankAPIError)
+		return nil, fmt.Errorf("%w: failed to decode response", errors.ErrMonobankAPIError)
+	}
+
+	return transactions, nil
+}
+
+func (s *MonobankService) syncCardTransactions(ctx context.Context, card *entity.Card, token string) error {
+	// Get last transaction time
+	lastTx, err := s.txRepo.GetByCardID(ctx, card.ID, 1, 0)
+	if err != nil {
+		return fmt.Errorf("%w: failed to get last transaction", errors.ErrDatabaseOperation)
+	}
+
+	var from int64
+	if len(lastTx) > 0 {
+		from = lastTx[0].TransactionDate.Unix()
+	} else {
+		// If no transactions, get last month
+		from = time.Now().AddDate(0, -1, 0).Unix()
+	}
+
+	// Get transactions from Monobank API
+	transactions, err := s.getMonobankStatement(card.MonobankAccountID, token, from, 0)
+	if err != nil {
+		return err
 	}
 
 	// Process transactions
@@ -388,6 +521,123 @@ func (s *MonobankService) syncCardTransactions(ctx context.Context, card *entity
 	return nil
 }
 
+// reimportCard reconciles the card's statement for the window, requesting older pages while
+// Monobank returns full ones. It reports whether any page was fetched; an error after that
+// means the older part of the window was not reconciled.
+func (s *MonobankService) reimportCard(ctx context.Context, card *entity.Card, token string, from, to time.Time, result *entity.MonobankReimportResult) (bool, error) {
+	fetched := false
+	seen := make(map[string]bool)
+	end := to.Unix()
+
+	for {
+		transactions, err := s.getMonobankStatement(card.MonobankAccountID, token, from.Unix(), end)
+		if err != nil {
+			return fetched, err
+		}
+		fetched = true
+
+		oldest := end
+		for _, monoTx := range transactions {
+			if monoTx.Time < oldest {
+				oldest = monoTx.Time
+			}
+			// Pages overlap at the boundary second
+			if seen[monoTx.ID] {
+				continue
+			}
+			seen[monoTx.ID] = true
+
+			result.Fetched++
+			if err := s.reconcileTransaction(ctx, &monoTx, card, result); err != nil {
+				s.log.Errorw("Failed to reconcile transaction",
+					"error", err,
+					"monobank_id", monoTx.ID,
+				)
+				result.FailedTransactions = append(result.FailedTransactions, monoTx.ID)
+			}
+		}
+
+		if len(transactions) < monobankStatementLimit {
+			return true, nil
+		}
+		if oldest >= end {
+			return true, fmt.Errorf("%w: more than %d transactions at %d", errors.ErrMonobankAPIError, monobankStatementLimit, end)
+		}
+		end = oldest
+	}
+}
+
+// reconcileTransaction creates the transaction if it is missing, or updates fields that Monobank corrected
+func (s *MonobankService) reconcileTransaction(ctx context.Context, monoTx *monobankTransaction, card *entity.Card, result *entity.MonobankReimportResult) error {
+	existing, err := s.txRepo.GetByMonobankID(ctx, monoTx.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseOperation, err)
+	}
+
+	fetched := s.convertMonobankTransaction(monoTx, card)
+	if existing == nil {
+		if err := s.txRepo.Create(ctx, fetched); err != nil {
+			return fmt.Errorf("%w: %v", errors.ErrDatabaseOperation, err)
+		}
+		result.Added = append(result.Added, *fetched)
+		return nil
+	}
+	if existing.UserID != card.UserID {
+		return fmt.Errorf("%w: transaction belongs to another user", errors.ErrInvalidTransactionData)
+	}
+
+	// Category is user-owned and never overwritten by corrections
+	var changes []entity.FieldChange
+	track := func(field string, oldValue, newValue interface{}) {
+		if oldValue != newValue {
+			changes = append(changes, entity.FieldChange{Field: field, Old: oldValue, New: newValue})
+		}
+	}
+	track("amount", existing.Amount, fetched.Amount)
+	track("operation_amount", existing.OperationAmount, fetched.OperationAmount)
+	track("currency_code", existing.CurrencyCode, fetched.CurrencyCode)
+	track("type", existing.Type, fetched.Type)
+	track("description", existing.Description, fetched.Description)
+	track("comment", existing.Comment, fetched.Comment)
+	track("mcc", existing.MCC, fetched.MCC)
+	track("commission_rate", existing.CommissionRate, fetched.CommissionRate)
+	track("cashback_amount", existing.CashbackAmount, fetched.CashbackAmount)
+	track("balance_after", existing.BalanceAfter, fetched.BalanceAfter)
+	track("hold", existing.Hold, fetched.Hold)
+	if !existing.TransactionDate.Equal(fetched.TransactionDate) {
+		changes = append(changes, entity.FieldChange{Field: "transaction_date", Old: existing.TransactionDate, New: fetched.TransactionDate})
+	}
+
+	if len(changes) == 0 {
+		result.Unchanged++
+		return nil
+	}
+
+	existing.Amount = fetched.Amount
+	existing.OperationAmount = fetched.OperationAmount
+	existing.CurrencyCode = fetched.CurrencyCode
+	existing.Type = fetched.Type
+	existing.Description = fetched.Description
+	existing.Comment = fetched.Comment
+	existing.MCC = fetched.MCC
+	existing.CommissionRate = fetched.CommissionRate
+	existing.CashbackAmount = fetched.CashbackAmount
+	existing.BalanceAfter = fetched.BalanceAfter
+	existing.Hold = fetched.Hold
+	existing.TransactionDate = fetched.TransactionDate
+
+	if err := s.txRepo.Update(ctx, existing); err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseOperation, err)
+	}
+
+	result.Updated = append(result.Updated, entity.TransactionDiff{
+		TransactionID: existing.ID,
+		MonobankID:    existing.MonobankID,
+		Changes:       changes,
+	})
+	return nil
+}
+
 func (s *MonobankService) convertMonobankTransaction(monoTx *monobankTransaction, card *entity.Card) *entity.Transaction {
 	txType := "expense"
 	if monoTx.Amount > 0 {
@@ -397,6 +647,7 @@ func (s *MonobankService) convertMonobankTransaction(monoTx *monobankTransaction
 	return &entity.Transaction{
 		CardID:          card.ID,
 		UserID:          card.UserID,
+		CategoryID:      card.DefaultCategoryID,
 		Amount:          abs(monoTx.Amount),
 		OperationAmount: abs(monoTx.OperationAmount),
 		CurrencyCode:    monoTx.CurrencyCode,
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	"cashone/domain/errors"
+	"cashone/domain/service"
+	"cashone/pkg/config"
+)
+
+// Supported password hashing algorithms
+const (
+	PasswordAlgorithmBcrypt   = "bcrypt"
+	PasswordAlgorithmArgon2id = "argon2id"
+)
+
+const argon2idPrefix = "$argon2id$"
+
+// passwordHasher hashes new passwords with the configured algorithm and verifies
+// hashes of any supported algorithm, detected by their versioned prefix
+type passwordHasher struct {
+	cfg *config.PasswordConfig
+}
+
+// NewPasswordHasher creates a new password hasher using the given configuration
+func NewPasswordHasher(cfg *config.PasswordConfig) (service.PasswordHasher, error) {
+	switch cfg.Algorithm {
+	case PasswordAlgorithmBcrypt:
+		if cfg.BcryptCost < bcrypt.MinCost || cfg.BcryptCost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("invalid bcrypt cost %d", cfg.BcryptCost)
+		}
+	case PasswordAlgorithmArgon2id:
+		if cfg.Argon2.Memory == 0 || cfg.Argon2.Iterations == 0 || cfg.Argon2.Parallelism == 0 {
+			return nil, fmt.Errorf("invalid argon2id parameters")
+		}
+		if cfg.Argon2.SaltLength == 0 || cfg.Argon2.KeyLength == 0 {
+			return nil, fmt.Errorf("invalid argon2id salt or key length")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported password hashing algorithm %q", cfg.Algorithm)
+	}
+	return &passwordHasher{cfg: cfg}, nil
+}
+
+// Hash hashes the password with the configured algorithm and parameters
+func (h *passwordHasher) Hash(password string) (string, error) {
+	if h.cfg.Algorithm == PasswordAlgorithmArgon2id {
+		return h.hashArgon2id(password)
+	}
+
+	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), h.cfg.BcryptCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hashedBytes), nil
+}
+
+// Verify checks the password against a hash produced by any supported algorithm
+func (h *passwordHasher) Verify(password, hash string) error {
+	if strings.HasPrefix(hash, argon2idPrefix) {
+		params, salt, key, err := decodeArgon2id(hash)
+		if err != nil {
+			return err
+		}
+		candidate := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+		if subtle.ConstantTimeCompare(candidate, key) != 1 {
+			return errors.ErrInvalidCredentials
+		}
+		return nil
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		return errors.ErrInvalidCredentials
+	}
+	return nil
+}
+
+// NeedsRehash reports whether the hash was produced with a different algorithm or parameters than configured
+func (h *passwordHasher) NeedsRehash(hash string) bool {
+	if strings.HasPrefix(hash, argon2idPrefix) {
+		if h.cfg.Algorithm != PasswordAlgorithmArgon2id {
+			return true
+		}
+		params, salt, key, err := decodeArgon2id(hash)
+		if err != nil {
+			return true
+		}
+		return params.Memory != h.cfg.Argon2.Memory ||
+			params.Iterations != h.cfg.Argon2.Iterations ||
+			params.Parallelism != h.cfg.Argon2.Parallelism ||
+			uint32(len(salt)) != h.cfg.Argon2.SaltLength ||
+			uint32(len(key)) != h.cfg.Argon2.KeyLength
+	}
+
+	if h.cfg.Algorithm != PasswordAlgorithmBcrypt {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return true
+	}
+	return cost != h.cfg.BcryptCost
+}
+
+func (h *passwordHasher) hashArgon2id(password string) (string, error) {
+	params := h.cfg.Argon2
+
+	salt := make([]byte, params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	// PHC string format: $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		params.Memory,
+		params.Iterations,
+		params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func decodeArgon2id(hash string) (config.Argon2Config, []byte, []byte, error) {
+	var params config.Argon2Config
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return params, nil, nil, fmt.Errorf("%w: malformed argon2id hash", errors.ErrInvalidCredentials)
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("%w: unsupported argon2id version", errors.ErrInvalidCredentials)
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, fmt.Errorf("%w: malformed argon2id parameters", errors.ErrInvalidCredentials)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("%w: malformed argon2id salt", errors.ErrInvalidCredentials)
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("%w: malformed argon2id key", errors.ErrInvalidCredentials)
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+	return params, salt, key, nil
+}
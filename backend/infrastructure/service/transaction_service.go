@@ -15,22 +15,37 @@ import (
 // TransactionService handles transaction-related business logic
 type TransactionService struct {
 	transactionRepo repository.TransactionRepository
+	cardRepo        repository.CardRepository
 	log             *zap.SugaredLogger
 }
 
 // NewTransactionService creates a new transaction service instance
 func NewTransactionService(
 	transactionRepo repository.TransactionRepository,
+	cardRepo repository.CardRepository,
 	log *zap.SugaredLogger,
 ) *TransactionService {
 	return &TransactionService{
 		transactionRepo: transactionRepo,
+		cardRepo:        cardRepo,
 		log:             log,
 	}
 }
 
-// Create creates a new transaction
+// Create creates a new transaction on one of the user's cards, falling back to the card's
+// default category when none is set
 func (s *TransactionService) Create(ctx context.Context, transaction *entity.Transaction) error {
+	card, err := s.cardRepo.GetByID(ctx, transaction.CardID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrDatabaseOperation, err)
+	}
+	if card == nil || card.UserID != transaction.UserID {
+		return errors.ErrCardNotFound
+	}
+
+	if transaction.CategoryID == nil {
+		transaction.CategoryID = card.DefaultCategoryID
+	}
 	return s.transactionRepo.Create(ctx, transaction)
 }
 
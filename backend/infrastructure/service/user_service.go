@@ -6,7 +6,6 @@ import (
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
-	"golang.org/x/crypto/bcrypt"
 
 	"cashone/domain/entity"
 	"cashone/domain/errors"
@@ -16,13 +15,19 @@ import (
 
 type userService struct {
 	userRepo repository.UserRepository
+	hasher   service.PasswordHasher
 	log      *zap.SugaredLogger
 }
 
 // NewUserService creates a new user service
-func NewUserService(userRepo repository.UserRepository, log *zap.SugaredLogger) service.UserService {
+func NewUserService(
+	userRepo repository.UserRepository,
+	hasher service.PasswordHasher,
+	log *zap.SugaredLogger,
+) service.UserService {
 	return &userService{
 		userRepo: userRepo,
+		hasher:   hasher,
 		log:      log,
 	}
 }
@@ -43,11 +48,11 @@ func (s *userService) Create(ctx context.Context, user *entity.User) error {
 	}
 
 	// Hash password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.PasswordHash), bcrypt.DefaultCost)
+	hashedPassword, err := s.hasher.Hash(user.PasswordHash)
 	if err != nil {
 		return fmt.Errorf("%w: failed to hash password", errors.ErrInternal)
 	}
-	user.PasswordHash = string(hashedPassword)
+	user.PasswordHash = hashedPassword
 
 	// Generate UUID if not provided
 	if user.ID == uuid.Nil {
@@ -106,11 +111,11 @@ func (s *userService) Update(ctx context.Context, user *entity.User) error {
 
 	// If password is being updated, hash it
 	if user.PasswordHash != existingUser.PasswordHash {
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.PasswordHash), bcrypt.DefaultCost)
+		hashedPassword, err := s.hasher.Hash(user.PasswordHash)
 		if err != nil {
 			return fmt.Errorf("%w: failed to hash password", errors.ErrInternal)
 		}
-		user.PasswordHash = string(hashedPassword)
+		user.PasswordHash = hashedPassword
 	}
 
 	// Update user
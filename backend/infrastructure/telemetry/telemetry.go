@@ -0,0 +1,176 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"cashone/pkg/config"
+	"cashone/pkg/version"
+)
+
+// defaultInterval is used when the configured report interval is not positive
+const defaultInterval = 24 * time.Hour
+
+// Report is the anonymous usage report sent to the telemetry endpoint.
+// It must never contain user, account or financial data.
+type Report struct {
+	InstanceID    string           `json:"instance_id"`
+	Version       string           `json:"version"`
+	Platform      string           `json:"platform"`
+	UptimeSeconds int64            `json:"uptime_seconds"`
+	PeriodStart   time.Time        `json:"period_start"`
+	PeriodEnd     time.Time        `json:"period_end"`
+	Features      map[string]int64 `json:"features"`
+	Errors        map[string]int64 `json:"errors"`
+}
+
+// Collector aggregates anonymous usage counters and periodically reports them.
+// A disabled collector ignores all calls.
+type Collector struct {
+	cfg        *config.TelemetryConfig
+	log        *zap.SugaredLogger
+	httpClient interface {
+		Do(*http.Request) (*http.Response, error)
+	}
+	instanceID string
+	startedAt  time.Time
+
+	mu          sync.Mutex
+	periodStart time.Time
+	features    map[string]int64
+	errors      map[string]int64
+}
+
+// NewCollector creates a new telemetry collector
+func NewCollector(cfg *config.TelemetryConfig, log *zap.SugaredLogger) *Collector {
+	now := time.Now().UTC()
+	return &Collector{
+		cfg:         cfg,
+		log:         log,
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+		instanceID:  uuid.New().String(),
+		startedAt:   now,
+		periodStart: now,
+		features:    make(map[string]int64),
+		errors:      make(map[string]int64),
+	}
+}
+
+// Enabled reports whether telemetry was opted into and has an endpoint configured
+func (c *Collector) Enabled() bool {
+	return c.cfg.Enabled && c.cfg.Endpoint != ""
+}
+
+// RecordFeature counts a single use of a feature
+func (c *Collector) RecordFeature(feature string) {
+	if !c.Enabled() || feature == "" {
+		return
+	}
+	c.mu.Lock()
+	c.features[feature]++
+	c.mu.Unlock()
+}
+
+// RecordError counts a single error of the given category
+func (c *Collector) RecordError(category string) {
+	if !c.Enabled() || category == "" {
+		return
+	}
+	c.mu.Lock()
+	c.errors[category]++
+	c.mu.Unlock()
+}
+
+// Run sends a report every configured interval until the context is cancelled
+func (c *Collector) Run(ctx context.Context) {
+	if !c.Enabled() {
+		if c.cfg.Enabled {
+			c.log.Warnw("Telemetry is enabled but no endpoint is configured, reports will not be sent")
+		}
+		return
+	}
+
+	interval := c.cfg.Interval
+	if interval <= 0 {
+		c.log.Warnw("Invalid telemetry interval, using default",
+			"interval", c.cfg.Interval,
+			"default", defaultInterval,
+		)
+		interval = defaultInterval
+	}
+
+	c.log.Infow("Anonymous usage telemetry is enabled",
+		"endpoint", c.cfg.Endpoint,
+		"interval", interval,
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.send(ctx, c.flush()); err != nil {
+				c.log.Warnw("Failed to send telemetry report", "error", err)
+			}
+		}
+	}
+}
+
+// flush returns the report for the current period and resets the counters
+func (c *Collector) flush() *Report {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now().UTC()
+	info := version.GetInfo()
+	report := &Report{
+		InstanceID:    c.instanceID,
+		Version:       info.Version,
+		Platform:      info.Platform,
+		UptimeSeconds: int64(now.Sub(c.startedAt).Seconds()),
+		PeriodStart:   c.periodStart,
+		PeriodEnd:     now,
+		Features:      c.features,
+		Errors:        c.errors,
+	}
+
+	c.periodStart = now
+	c.features = make(map[string]int64)
+	c.errors = make(map[string]int64)
+	return report
+}
+
+func (c *Collector) send(ctx context.Context, report *Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -11,14 +11,17 @@ import (
 
 // Config represents the application's configuration
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Logger   LoggerConfig   `mapstructure:"logger"`
-	Swagger  SwaggerConfig  `mapstructure:"swagger"`
-	Metrics  MetricsConfig  `mapstructure:"metrics"`
-	Features FeaturesConfig `mapstructure:"features"`
-	Auth     AuthConfig     `mapstructure:"auth"`
-	Security SecurityConfig `mapstructure:"security"`
+	Server      ServerConfig      `mapstructure:"server"`
+	Database    DatabaseConfig    `mapstructure:"database"`
+	Logger      LoggerConfig      `mapstructure:"logger"`
+	Swagger     SwaggerConfig     `mapstructure:"swagger"`
+	Metrics     MetricsConfig     `mapstructure:"metrics"`
+	Features    FeaturesConfig    `mapstructure:"features"`
+	Auth        AuthConfig        `mapstructure:"auth"`
+	Security    SecurityConfig    `mapstructure:"security"`
+	Telemetry   TelemetryConfig   `mapstructure:"telemetry"`
+	Admin       AdminConfig       `mapstructure:"admin"`
+	Maintenance MaintenanceConfig `mapstructure:"maintenance"`
 }
 
 // ServerConfig holds server-related configuration
@@ -53,10 +56,22 @@ type DatabaseConfig struct {
 
 // LoggerConfig holds logging-related configuration
 type LoggerConfig struct {
-	Level            string   `mapstructure:"level"`
-	Encoding         string   `mapstructure:"encoding"`
-	OutputPaths      []string `mapstructure:"output_paths"`
-	ErrorOutputPaths []string `mapstructure:"error_output_paths"`
+	Level            string      `mapstructure:"level"`
+	Encoding         string      `mapstructure:"encoding"`
+	OutputPaths      []string    `mapstructure:"output_paths"`
+	ErrorOutputPaths []string    `mapstructure:"error_output_paths"`
+	Audit            AuditConfig `mapstructure:"audit"`
+}
+
+// AuditConfig holds configuration of the audit and security event exporter
+type AuditConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	Format        string `mapstructure:"format"` // jsonl or cef
+	Output        string `mapstructure:"output"` // file or syslog
+	Path          string `mapstructure:"path"`
+	SyslogNetwork string `mapstructure:"syslog_network"`
+	SyslogAddress string `mapstructure:"syslog_address"`
+	BufferSize    int    `mapstructure:"buffer_size"`
 }
 
 // SwaggerConfig holds Swagger documentation configuration
@@ -77,6 +92,27 @@ type FeaturesConfig struct {
 	MonobankIntegration bool `mapstructure:"monobank_integration"`
 }
 
+// TelemetryConfig holds anonymous usage telemetry configuration (opt-in)
+type TelemetryConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Endpoint string        `mapstructure:"endpoint"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// AdminConfig holds configuration of operator endpoints
+type AdminConfig struct {
+	Token string `mapstructure:"token"`
+}
+
+// MaintenanceConfig holds read-only maintenance mode configuration.
+// The switch itself is persisted in the database and shared by all instances.
+type MaintenanceConfig struct {
+	Enabled      bool          `mapstructure:"enabled"`
+	Reason       string        `mapstructure:"reason"`
+	RetryAfter   time.Duration `mapstructure:"retry_after"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+}
+
 // AuthConfig holds authentication-related configuration
 type AuthConfig struct {
 	AccessTokenSecret  string `mapstructure:"access_token_secret"`
@@ -87,7 +123,8 @@ type AuthConfig struct {
 
 // SecurityConfig holds security-related configuration
 type SecurityConfig struct {
-	JWT JWTConfig `mapstructure:"jwt"`
+	JWT      JWTConfig      `mapstructure:"jwt"`
+	Password PasswordConfig `mapstructure:"password"`
 }
 
 // JWTConfig holds JWT-specific configuration
@@ -99,6 +136,23 @@ type JWTConfig struct {
 	Audience               string        `mapstructure:"audience"`
 }
 
+// PasswordConfig holds password hashing configuration.
+// Existing hashes are upgraded on the next successful login when the algorithm or parameters change.
+type PasswordConfig struct {
+	Algorithm  string       `mapstructure:"algorithm"`
+	BcryptCost int          `mapstructure:"bcrypt_cost"`
+	Argon2     Argon2Config `mapstructure:"argon2"`
+}
+
+// Argon2Config holds argon2id hashing parameters
+type Argon2Config struct {
+	Memory      uint32 `mapstructure:"memory"` // in KiB
+	Iterations  uint32 `mapstructure:"iterations"`
+	Parallelism uint8  `mapstructure:"parallelism"`
+	SaltLength  uint32 `mapstructure:"salt_length"`
+	KeyLength   uint32 `mapstructure:"key_length"`
+}
+
 // Load loads the configuration from files and environment variables
 func Load() (*Config, error) {
 	v := viper.New()
@@ -150,6 +204,7 @@ func Load() (*Config, error) {
 	v.BindEnv("database.user", "CASHONE_DATABASE_USER")
 	v.BindEnv("database.password", "CASHONE_DATABASE_PASSWORD")
 	v.BindEnv("server.port", "CASHONE_SERVER_PORT")
+	v.BindEnv("admin.token", "CASHONE_ADMIN_TOKEN")
 
 	// If JWT secret is set in environment, override the default
 	if jwtSecret := os.Getenv("CASHONE_JWT_SECRET"); jwtSecret != "" {
@@ -197,6 +252,13 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("logger.encoding", "json")
 	v.SetDefault("logger.output_paths", []string{"stdout"})
 	v.SetDefault("logger.error_output_paths", []string{"stderr"})
+	v.SetDefault("logger.audit.enabled", false)
+	v.SetDefault("logger.audit.format", "jsonl")
+	v.SetDefault("logger.audit.output", "file")
+	v.SetDefault("logger.audit.path", "audit.log")
+	v.SetDefault("logger.audit.syslog_network", "udp")
+	v.SetDefault("logger.audit.syslog_address", "localhost:514")
+	v.SetDefault("logger.audit.buffer_size", 1024)
 
 	// Swagger defaults
 	v.SetDefault("swagger.enabled", true)
@@ -210,6 +272,16 @@ func setDefaults(v *viper.Viper) {
 	// Features defaults
 	v.SetDefault("features.monobank_integration", true)
 
+	// Telemetry defaults (disabled unless explicitly enabled)
+	v.SetDefault("telemetry.enabled", false)
+	v.SetDefault("telemetry.endpoint", "")
+	v.SetDefault("telemetry.interval", 24*time.Hour)
+
+	// Maintenance defaults
+	v.SetDefault("maintenance.enabled", false)
+	v.SetDefault("maintenance.retry_after", 5*time.Minute)
+	v.SetDefault("maintenance.poll_interval", 15*time.Second)
+
 	// Auth defaults
 	v.SetDefault("auth.access_token_ttl", "15m")
 	v.SetDefault("auth.refresh_token_ttl", "7d")
@@ -220,4 +292,11 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("security.jwt.refresh_token_expiration", 7*24*time.Hour)
 	v.SetDefault("security.jwt.issuer", "cashone")
 	v.SetDefault("security.jwt.audience", "cashone-users")
+	v.SetDefault("security.password.algorithm", "bcrypt")
+	v.SetDefault("security.password.bcrypt_cost", 10)
+	v.SetDefault("security.password.argon2.memory", 64*1024)
+	v.SetDefault("security.password.argon2.iterations", 3)
+	v.SetDefault("security.password.argon2.parallelism", 2)
+	v.SetDefault("security.password.argon2.salt_length", 16)
+	v.SetDefault("security.password.argon2.key_length", 32)
 }